@@ -1,28 +1,160 @@
 package config
 
 import (
-	"log"
+	"fmt"
 	"os"
+	"time"
 
 	"gopkg.in/yaml.v2"
 )
 
 // Config, uygulama konfigürasyonunu temsil eder.
 type Config struct {
-	Vision struct {
-		CredentialsFile string `yaml:"credentialsFile"`
-	} `yaml:"vision"`
-	Kafka struct {
-		Broker string `yaml:"broker"`
-	} `yaml:"kafka"`
+	Vision    VisionConfig    `yaml:"vision"`
+	RateLimit RateLimitConfig `yaml:"rate_limit"`
+	Admin     struct {
+		Addr string `yaml:"addr"` // Yönetim HTTP uç noktasının adresi (örneğin, :8081); boşsa başlatılmaz
+	} `yaml:"admin"`
+	Server  ServerConfig  `yaml:"server"`
+	Health  HealthConfig  `yaml:"health"`
+	Tracing TracingConfig `yaml:"tracing"`
+	Gateway struct {
+		Addr string `yaml:"addr"` // REST geçidinin adresi (örneğin, :8080); boşsa başlatılmaz
+	} `yaml:"gateway"`
+	Kafka      KafkaConfig      `yaml:"kafka"`
+	Log        LogConfig        `yaml:"log"`
+	Moderation ModerationConfig `yaml:"moderation"`
+	Emotion    EmotionConfig    `yaml:"emotion"`
+	Ranking    RankingConfig    `yaml:"ranking"`
+	Analytics  AnalyticsConfig  `yaml:"analytics"`
+	Watch      WatchConfig      `yaml:"watch"`
+	Batch      BatchConfig      `yaml:"batch"`
+	Geo        struct {
+		Index string `yaml:"index"` // Konum dizini: "postgres" (varsayılan) veya "memory"
+	} `yaml:"geo"`
+}
+
+// ModerationConfig, SafeSearch tabanlı içerik denetimi eşiklerini tutar.
+// Eşikler kategori adından (adult, violence, racy, medical, spoof) Vision API olasılık adına
+// (UNLIKELY, POSSIBLE, LIKELY, VERY_LIKELY) eşlenir. Eşiği olmayan kategori o karar için değerlendirilmez.
+type ModerationConfig struct {
+	Enabled    bool              `yaml:"enabled"`
+	Quarantine map[string]string `yaml:"quarantine"` // Bu eşiğe ulaşan fotoğraf karantinaya alınır
+	Reject     map[string]string `yaml:"reject"`     // Bu eşiğe ulaşan fotoğraf reddedilir
+	// Moderators, ModerationService'i çağırabilecek doğrulanmış istemci kimlikleridir (bağlantı adresi ya da
+	// "cert:<CN>"). Boşsa hizmetin tüm çağrıları reddedilir.
+	Moderators []string `yaml:"moderators"`
+}
+
+// EmotionConfig, duygu sınıflandırma kurallarının yüklendiği dosyayı tutar.
+// Dosya boşsa yalnızca en yüksek olasılıklı duygu kullanılır.
+type EmotionConfig struct {
+	RulesFile      string        `yaml:"rules_file"`
+	ReloadInterval time.Duration `yaml:"reload_interval"` // Kural dosyasının değişiklik için yoklanma aralığı (örneğin, 30s)
+}
+
+// RankingConfig, akış sıralama stratejilerinin ağırlıklarını tutar.
+type RankingConfig struct {
+	Default              string             `yaml:"default"`                // recency, emotion, hot veya random
+	EmotionWeights       map[string]float64 `yaml:"emotion_weights"`        // Duygu etiketi -> ağırlık (örneğin, joy: 1.0)
+	DefaultEmotionWeight float64            `yaml:"default_emotion_weight"` // Listede olmayan duyguların ağırlığı
+	Hot                  struct {
+		EmotionWeight float64       `yaml:"emotion_weight"`
+		RecencyWeight float64       `yaml:"recency_weight"`
+		HalfLife      time.Duration `yaml:"half_life"` // Yenilik puanının yarıya indiği süre (örneğin, 24h)
+	} `yaml:"hot"`
+}
+
+// AnalyticsConfig, duygu istatistiklerinin nasıl hesaplanacağını belirler. Özet kapalıyken istatistikler her
+// istekte fotoğraf tablolarından hesaplanır.
+type AnalyticsConfig struct {
+	Rollup          bool          `yaml:"rollup"`           // Saatlik özet tablosunu kullan
+	RefreshInterval time.Duration `yaml:"refresh_interval"` // Özetin arka planda yenilenme aralığı (örneğin, 1m)
+}
+
+// WatchConfig, WatchFeed akışlarının kuyruk ve saklama ayarlarını tutar.
+type WatchConfig struct {
+	BufferSize   int           `yaml:"buffer_size"`   // Abone başına bekletilen en fazla olay; dolarsa abone çıkarılır
+	PollInterval time.Duration `yaml:"poll_interval"` // Bildirim gelmese de değişikliklerin yoklanma aralığı
+	Retention    time.Duration `yaml:"retention"`     // Değişiklik kayıtlarının ve devam belirteçlerinin saklanma süresi
+}
+
+// BatchConfig, toplu yükleme sınırlarını tutar.
+type BatchConfig struct {
+	MaxImages   int `yaml:"max_images"`  // Tek istekte kabul edilen en fazla fotoğraf
+	Concurrency int `yaml:"concurrency"` // Aynı anda işlenen en fazla fotoğraf
+}
+
+// VisionConfig, Vision API istemcisinin ayarlarını tutar.
+type VisionConfig struct {
+	CredentialsFile string              `yaml:"credentialsFile"`
+	Cache           VisionCacheConfig   `yaml:"cache"`
+	MaxConcurrent   int                 `yaml:"max_concurrent"` // Aynı anda yapılabilecek en fazla Vision API çağrısı; 0 ise sınırsız
+	Budget          VisionBudgetConfig  `yaml:"budget"`
+	Timeout         time.Duration       `yaml:"timeout"` // Tek bir Vision API çağrısının süre sınırı
+	Retry           VisionRetryConfig   `yaml:"retry"`
+	Breaker         VisionBreakerConfig `yaml:"breaker"`
+	Fetch           FetchConfig         `yaml:"fetch"`
+}
+
+// FetchConfig, kullanıcının verdiği URL'lerden görüntü indirme ayarlarıdır.
+type FetchConfig struct {
+	Schemes      []string      `yaml:"schemes"`       // İzin verilen URL şemaları (http, https)
+	MaxRedirects int           `yaml:"max_redirects"` // İzlenecek en fazla yönlendirme
+	Timeout      time.Duration `yaml:"timeout"`       // İndirmenin toplam süre sınırı
+	MaxBytes     int64         `yaml:"max_bytes"`     // İndirilecek en büyük görüntü
+	AllowedCIDRs []string      `yaml:"allowed_cidrs"` // İç ağ engeline rağmen izin verilen adres blokları
+}
+
+// VisionRetryConfig, geçici Vision API hatalarının (Unavailable, DeadlineExceeded vb.) yeniden deneme ayarlarıdır.
+type VisionRetryConfig struct {
+	MaxAttempts    int           `yaml:"max_attempts"`
+	InitialBackoff time.Duration `yaml:"initial_backoff"`
+	MaxBackoff     time.Duration `yaml:"max_backoff"`
+}
+
+// VisionBreakerConfig, Vision API devre kesicisinin ayarlarıdır.
+type VisionBreakerConfig struct {
+	FailureThreshold int           `yaml:"failure_threshold"` // Devreyi açan art arda başarısız çağrı sayısı
+	Cooldown         time.Duration `yaml:"cooldown"`          // Açık devrenin deneme çağrısına izin vermeden önce beklediği süre
+}
+
+// VisionBudgetConfig, kiracı başına günlük Vision birimi bütçelerini tutar. Bir birim, bir görüntü için
+// istenen bir özelliktir (örneğin, yüz ve etiket tespiti birlikte iki birim). Günler UTC'ye göre sayılır.
+type VisionBudgetConfig struct {
+	DailyUnits int            `yaml:"daily_units"` // Varsayılan günlük bütçe; 0 ise sınırsız
+	Tenants    map[string]int `yaml:"tenants"`     // Kiracıya özel bütçeler; 0 ise o kiracı için sınırsız
+}
+
+// RateLimitConfig, istemci ve RPC başına jeton kovası sınırlarını tutar. İstemciler doğrulanmış istemci
+// sertifikasıyla, yoksa bağlantı adresiyle ayırt edilir; REST geçidinden gelen isteklerde HTTP istemcisinin
+// adresi kullanılır. BatchUploadImages'taki her fotoğraf ayrıca UploadImage sınırından düşülür.
+type RateLimitConfig struct {
+	Enabled bool                 `yaml:"enabled"`
+	Default RateLimit            `yaml:"default"`
+	Methods map[string]RateLimit `yaml:"methods"` // RPC adı (örneğin, UploadImage) veya tam yolu -> sınır
+}
+
+// RateLimit, saniyede dolan jeton sayısı ve kovanın kapasitesidir. RPS 0 ise sınır uygulanmaz.
+type RateLimit struct {
+	RPS   float64 `yaml:"rps"`
+	Burst int     `yaml:"burst"`
+}
+
+// VisionCacheConfig, Vision API sonuç önbelleğinin ayarlarını tutar. Sonuçlar görüntü içeriğine (veya URL ve
+// ETag'e) ve istenen özelliklere göre saklanır.
+type VisionCacheConfig struct {
+	Enabled  bool          `yaml:"enabled"`
+	Size     int           `yaml:"size"`     // Bellekte tutulan en fazla sonuç
+	TTL      time.Duration `yaml:"ttl"`      // Sonucun geçerli kaldığı süre (örneğin, 24h)
+	Postgres bool          `yaml:"postgres"` // Sonuçları kopyalar arasında paylaşmak için Postgres'te de saklar
 }
 
 // LoadConfig, belirtilen YAML dosyasından konfigürasyonu yükler.
 func LoadConfig(filePath string) (*Config, error) {
 	file, err := os.Open(filePath)
 	if err != nil {
-		log.Fatalf("Konfigürasyon dosyası açılamadı: %v", err)
-		return nil, err
+		return nil, fmt.Errorf("konfigürasyon dosyası açılamadı: %w", err)
 	}
 	defer file.Close()
 
@@ -30,9 +162,43 @@ func LoadConfig(filePath string) (*Config, error) {
 	var config Config
 	err = decoder.Decode(&config)
 	if err != nil {
-		log.Fatalf("Konfigürasyon dosyası çözümlenemedi: %v", err)
-		return nil, err
+		return nil, fmt.Errorf("konfigürasyon dosyası çözümlenemedi: %w", err)
 	}
 
 	return &config, nil
 }
+
+// ServerConfig, gRPC sunucusunun ayarlarıdır.
+type ServerConfig struct {
+	Reflection      bool          `yaml:"reflection"`       // gRPC sunucu yansıtma servisini kaydeder
+	ShutdownTimeout time.Duration `yaml:"shutdown_timeout"` // Kapanışta açık isteklerin bitmesi için beklenecek en uzun süre
+}
+
+// HealthConfig, grpc.health.v1 servisinde yayımlanan bağımlılık kontrollerinin ayarlarıdır.
+type HealthConfig struct {
+	Interval time.Duration `yaml:"interval"` // Kontrollerin çalışma aralığı
+	Timeout  time.Duration `yaml:"timeout"`  // Tek bir kontrolün süre sınırı
+}
+
+// TracingConfig, OpenTelemetry izlerinin nereye ve ne oranda gönderileceğini belirler.
+type TracingConfig struct {
+	Exporter    string  `yaml:"exporter"`     // İzlerin dışa aktarıcısı: "otlp", "stdout" veya "none" (varsayılan)
+	Endpoint    string  `yaml:"endpoint"`     // OTLP/gRPC toplayıcısının adresi (örneğin, localhost:4317)
+	Insecure    bool    `yaml:"insecure"`     // Toplayıcıya TLS olmadan bağlanır
+	ServiceName string  `yaml:"service_name"` // İzlerde görünen servis adı
+	SampleRatio float64 `yaml:"sample_ratio"` // Kök izlerin örneklenme oranı (0-1); 0 veya 1 hepsini örnekler
+}
+
+// KafkaConfig, Kafka üreticisinin ayarlarıdır.
+type KafkaConfig struct {
+	Broker          string        `yaml:"broker"`
+	Delivery        string        `yaml:"delivery"`         // "async" (varsayılan): teslim sonucu arka planda işlenir; "sync": gönderen teslimi bekler
+	DeliveryTimeout time.Duration `yaml:"delivery_timeout"` // Mesajın teslim edilmesi için beklenecek en uzun süre
+	FlushTimeout    time.Duration `yaml:"flush_timeout"`    // Kapanışta kuyruktaki mesajların teslimi için beklenecek en uzun süre
+}
+
+// LogConfig, uygulama günlüklerinin biçimini ve seviyesini belirler.
+type LogConfig struct {
+	Format string `yaml:"format"` // "json" veya "text" (varsayılan)
+	Level  string `yaml:"level"`  // "debug", "info" (varsayılan), "warn" veya "error"
+}
@@ -0,0 +1,42 @@
+package photo
+
+import (
+	"encoding/json"
+	"log/slog"
+	"net/http"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// visionStatus, /admin/vision uç noktasının yanıtıdır.
+type visionStatus struct {
+	Breaker         BreakerSnapshot `json:"breaker"`
+	PendingAnalyses *int            `json:"pending_analyses,omitempty"` // Sayılamadıysa boştur
+}
+
+// NewAdminHandler, işletim için durum bilgisi veren HTTP uç noktalarını oluşturur. Prometheus ölçümleri
+// /metrics yolunda sunulur.
+func NewAdminHandler(vision *VisionAPI, metrics *prometheus.Registry) http.Handler {
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", MetricsHandler(metrics))
+	mux.HandleFunc("/admin/vision", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			w.Header().Set("Allow", http.MethodGet)
+			http.Error(w, "yalnızca GET desteklenir", http.StatusMethodNotAllowed)
+			return
+		}
+
+		resp := visionStatus{Breaker: vision.Breaker()}
+		if n, err := PendingAnalysisCount(r.Context()); err != nil {
+			slog.ErrorContext(r.Context(), "Analiz kuyruğu sayılamadı", "error", err)
+		} else {
+			resp.PendingAnalyses = &n
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(resp); err != nil {
+			slog.ErrorContext(r.Context(), "Yönetim yanıtı yazılamadı", "error", err)
+		}
+	})
+	return mux
+}
@@ -0,0 +1,231 @@
+package photo
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"log/slog"
+	"strconv"
+	"time"
+)
+
+// Analiz durumlarının veritabanı değerleri.
+const (
+	analysisComplete = "COMPLETE"
+	analysisPending  = "PENDING"
+	analysisFailed   = "FAILED"
+)
+
+const (
+	analysisQueueBatchSize   = 10               // Tek seferde işlenen en fazla kuyruk kaydı
+	analysisQueueLease       = 5 * time.Minute  // İşlenen kaydın başka bir işçiye verilmeden önce beklediği süre
+	analysisQueueMaxAttempts = 10               // Bu kadar denemeden sonra analiz başarısız sayılır
+	analysisRetryBaseDelay   = 30 * time.Second // İlk yeniden denemeden önceki bekleme
+	analysisRetryMaxDelay    = time.Hour
+)
+
+// analysisStatusToDB, analiz durumunu veritabanı değerine çevirir.
+func analysisStatusToDB(s AnalysisStatus) string {
+	switch s {
+	case AnalysisStatus_ANALYSIS_PENDING:
+		return analysisPending
+	case AnalysisStatus_ANALYSIS_FAILED:
+		return analysisFailed
+	}
+	return analysisComplete
+}
+
+// analysisStatusFromDB, veritabanı değerini analiz durumuna çevirir.
+func analysisStatusFromDB(s string) AnalysisStatus {
+	switch s {
+	case analysisPending:
+		return AnalysisStatus_ANALYSIS_PENDING
+	case analysisFailed:
+		return AnalysisStatus_ANALYSIS_FAILED
+	}
+	return AnalysisStatus_ANALYSIS_COMPLETE
+}
+
+// enqueueAnalysis, fotoğrafı verilen analiz seçenekleriyle kuyruğa verilen işlem içinde ekler.
+func enqueueAnalysis(tx *sql.Tx, photoID int64, opts AnalyzeOptions) error {
+	options, err := json.Marshal(opts)
+	if err != nil {
+		return err
+	}
+	t := now().UTC()
+	_, err = tx.Exec(`INSERT INTO analysis_queue (photo_id, options, next_attempt_at, enqueued_at)
+                      VALUES ($1, $2, $3, $3)
+                      ON CONFLICT (photo_id) DO UPDATE SET options = EXCLUDED.options, next_attempt_at = EXCLUDED.next_attempt_at`,
+		photoID, options, t)
+	return err
+}
+
+// queuedAnalysis, işlenmek üzere alınmış bir kuyruk kaydıdır.
+type queuedAnalysis struct {
+	photoID  int64
+	options  AnalyzeOptions
+	attempts int
+}
+
+// claimQueuedAnalyses, zamanı gelmiş kayıtları alır ve başka işçilerin aynı anda almaması için sonraki deneme
+// zamanlarını ileri atar. İşlem yarıda kalırsa kayıt bu süre dolunca yeniden alınır.
+func claimQueuedAnalyses(ctx context.Context, limit int) ([]queuedAnalysis, error) {
+	t := now().UTC()
+	rows, err := db.QueryContext(ctx, `
+		UPDATE analysis_queue q
+		SET attempts = q.attempts + 1, next_attempt_at = $3
+		WHERE q.photo_id IN (
+			SELECT photo_id FROM analysis_queue
+			WHERE next_attempt_at <= $1
+			ORDER BY next_attempt_at
+			LIMIT $2
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING q.photo_id, q.options, q.attempts`, t, limit, t.Add(analysisQueueLease))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var claimed []queuedAnalysis
+	for rows.Next() {
+		var item queuedAnalysis
+		var options []byte
+		if err := rows.Scan(&item.photoID, &options, &item.attempts); err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal(options, &item.options); err != nil {
+			return nil, err
+		}
+		claimed = append(claimed, item)
+	}
+	return claimed, rows.Err()
+}
+
+// rescheduleAnalysis, başarısız denemeden sonra kaydı üstel artan bir beklemeyle yeniden zamanlar.
+func rescheduleAnalysis(photoID int64, attempts int, cause error) error {
+	delay := analysisRetryBaseDelay << (attempts - 1)
+	if delay <= 0 || delay > analysisRetryMaxDelay {
+		delay = analysisRetryMaxDelay
+	}
+	_, err := db.Exec(`UPDATE analysis_queue SET next_attempt_at = $2, last_error = $3 WHERE photo_id = $1`,
+		photoID, now().UTC().Add(delay), cause.Error())
+	return err
+}
+
+// markAnalysisFailed, fotoğrafın analizini başarısız olarak işaretler ve kuyruktan çıkarır. Fotoğraf denetim
+// kararı verilemediği için bekleyen durumda kalır ve akışta görünmez.
+func markAnalysisFailed(photoID int64) error {
+	tx, err := db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.Exec(`UPDATE photos SET analysis_status = $2 WHERE id = $1`, photoID, analysisFailed); err != nil {
+		return err
+	}
+	if _, err := tx.Exec(`DELETE FROM analysis_queue WHERE photo_id = $1`, photoID); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// PendingAnalysisCount, analiz kuyruğunda bekleyen fotoğraf sayısını döndürür.
+func PendingAnalysisCount(ctx context.Context) (int, error) {
+	var n int
+	err := db.QueryRowContext(ctx, `SELECT COUNT(*) FROM analysis_queue`).Scan(&n)
+	return n, err
+}
+
+// ProcessAnalysisQueue, Vision API'ye ulaşılamadığı için bekleyen fotoğrafları analiz eder ve işlenen kayıt
+// sayısını döndürür. Devre kesici açıksa kuyruğa dokunulmaz.
+func (s *PhotoService) ProcessAnalysisQueue(ctx context.Context) (int, error) {
+	if s.visionAPI.Breaker().State == breakerOpen {
+		return 0, nil
+	}
+
+	claimed, err := claimQueuedAnalyses(ctx, analysisQueueBatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	processed := 0
+	for _, item := range claimed {
+		err := s.completeAnalysis(ctx, item)
+		switch {
+		case err == nil:
+			processed++
+		case ctx.Err() != nil:
+			// Kapanış sırasında yarıda kalan kayıt kira süresi dolunca yeniden alınır.
+		case item.attempts >= analysisQueueMaxAttempts:
+			slog.ErrorContext(ctx, "Fotoğraf analizi başarısız oldu", "photo_id", item.photoID, "error", err)
+			if merr := markAnalysisFailed(item.photoID); merr != nil {
+				slog.ErrorContext(ctx, "Analiz durumu güncellenemedi", "photo_id", item.photoID, "error", merr)
+				break
+			}
+			s.publishEvent(ctx, &EventEnvelope_AnalysisFailed{AnalysisFailed: &AnalysisFailed{
+				PhotoId:  strconv.FormatInt(item.photoID, 10),
+				Reason:   err.Error(),
+				Attempts: int32(item.attempts),
+			}})
+		default:
+			if err := rescheduleAnalysis(item.photoID, item.attempts, err); err != nil {
+				slog.ErrorContext(ctx, "Analiz yeniden zamanlanamadı", "photo_id", item.photoID, "error", err)
+			}
+		}
+
+		// Deneme sırasında devre açıldıysa kalan kayıtlar kira süresi dolunca yeniden alınır.
+		if ctx.Err() != nil || s.visionAPI.Breaker().State == breakerOpen {
+			break
+		}
+	}
+	return processed, ctx.Err()
+}
+
+// completeAnalysis, bekleyen fotoğrafı yükleme sırasında yapılacak analizle tamamlar ve kuyruktan çıkarır.
+func (s *PhotoService) completeAnalysis(ctx context.Context, item queuedAnalysis) error {
+	img, err := GetPhotoByID(ctx, strconv.FormatInt(item.photoID, 10))
+	if err != nil {
+		return err
+	}
+
+	opts := item.options
+	opts.Landmarks = opts.Landmarks && img.Location == nil
+	analysis, err := s.visionAPI.AnalyzeImage(ctx, img.Url, opts)
+	if err != nil {
+		return err
+	}
+
+	img.EmotionRulesVersion = s.emotions.ClassifyFaces(analysis.Faces)
+	img.FaceAnalysis = toProtoFaces(analysis.Faces)
+	img.ImageWidth = int32(analysis.Width)
+	img.ImageHeight = int32(analysis.Height)
+	img.Labels = toProtoLabels(analysis.Labels)
+	img.Text = toProtoText(analysis.Text)
+	img.SafeSearch = analysis.SafeSearch
+
+	// Fotoğraf zaten kayıtlı olduğundan reddedilen fotoğraf silinmez, reddedildi olarak saklanır.
+	img.ModerationStatus, img.ModerationReason = s.moderation.Evaluate(analysis.SafeSearch)
+	if img.Location == nil {
+		img.Location = resolveLocation(nil, analysis.Landmark)
+	}
+	img.AnalysisStatus = AnalysisStatus_ANALYSIS_COMPLETE
+
+	if err := UpdatePhoto(ctx, img); err != nil {
+		return err
+	}
+	syncLocationIndex(ctx, s.locations, img)
+
+	var dominantEmotion string
+	if len(img.FaceAnalysis) > 0 {
+		dominantEmotion = img.FaceAnalysis[0].Emotion
+	}
+	s.publishEvent(ctx, &EventEnvelope_AnalysisCompleted{AnalysisCompleted: &AnalysisCompleted{
+		PhotoId:          img.Id,
+		FaceCount:        int32(len(img.FaceAnalysis)),
+		DominantEmotion:  dominantEmotion,
+		ModerationStatus: img.ModerationStatus,
+	}})
+	return nil
+}
@@ -0,0 +1,253 @@
+package photo
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strconv"
+	"strings"
+	"time"
+
+	"myphotoapp/config"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// maxStatsBuckets, tek istekte döndürülebilecek en fazla zaman aralığı sayısıdır.
+const maxStatsBuckets = 2000
+
+// statsBucketUnits, aralık türlerinin date_trunc birimleri ve yaklaşık süreleridir. Süreler yalnızca istenen
+// aralık sayısını sınırlamak için kullanılır.
+var statsBucketUnits = map[StatsBucket]struct {
+	unit     string
+	duration time.Duration
+}{
+	StatsBucket_STATS_BUCKET_HOUR:  {"hour", time.Hour},
+	StatsBucket_STATS_BUCKET_DAY:   {"day", 24 * time.Hour},
+	StatsBucket_STATS_BUCKET_WEEK:  {"week", 7 * 24 * time.Hour},
+	StatsBucket_STATS_BUCKET_MONTH: {"month", 28 * 24 * time.Hour},
+}
+
+// emotionFacesSQL, istatistiklere giren her yüzü yüklenme zamanı, sahip ve albümüyle listeler. Yalnızca denetimden
+// geçmiş fotoğraflar sayılır; yüz kaydı olmayan eski fotoğraflarda photos tablosundaki tek duygu kullanılır.
+const emotionFacesSQL = `
+        SELECT p.upload_time, COALESCE(p.owner_id, '') AS owner_id, COALESCE(p.album_id, '') AS album_id,
+               f.emotion, f.confidence
+        FROM photos p JOIN photo_faces f ON f.photo_id = p.id
+        WHERE p.moderation_status = 'ACCEPTED'
+        UNION ALL
+        SELECT p.upload_time, COALESCE(p.owner_id, ''), COALESCE(p.album_id, ''), p.emotion, p.confidence
+        FROM photos p
+        WHERE p.moderation_status = 'ACCEPTED' AND p.emotion IS NOT NULL
+          AND NOT EXISTS (SELECT 1 FROM photo_faces f WHERE f.photo_id = p.id)`
+
+// emotionRollupStatements, saatlik özet tablosunu ve değişen saatleri işaretleyen tetikleyiciyi oluşturur.
+// Tetikleyici yalnızca istatistikleri etkileyen sütunlar değiştiğinde çalışır.
+var emotionRollupStatements = []string{
+	`CREATE TABLE IF NOT EXISTS emotion_stats_hourly (
+        bucket_start TIMESTAMP NOT NULL,
+        owner_id TEXT NOT NULL,
+        album_id TEXT NOT NULL,
+        emotion TEXT NOT NULL,
+        face_count BIGINT NOT NULL,
+        confidence_sum FLOAT NOT NULL,
+        PRIMARY KEY (bucket_start, owner_id, album_id, emotion)
+    )`,
+	`CREATE TABLE IF NOT EXISTS emotion_stats_dirty (
+        bucket_start TIMESTAMP NOT NULL,
+        owner_id TEXT NOT NULL,
+        album_id TEXT NOT NULL,
+        PRIMARY KEY (bucket_start, owner_id, album_id)
+    )`,
+	`CREATE OR REPLACE FUNCTION mark_emotion_stats_dirty() RETURNS trigger AS $$
+    BEGIN
+        IF TG_OP IN ('UPDATE', 'DELETE') AND OLD.upload_time IS NOT NULL THEN
+            INSERT INTO emotion_stats_dirty
+            VALUES (date_trunc('hour', OLD.upload_time), COALESCE(OLD.owner_id, ''), COALESCE(OLD.album_id, ''))
+            ON CONFLICT DO NOTHING;
+        END IF;
+        IF TG_OP IN ('INSERT', 'UPDATE') AND NEW.upload_time IS NOT NULL THEN
+            INSERT INTO emotion_stats_dirty
+            VALUES (date_trunc('hour', NEW.upload_time), COALESCE(NEW.owner_id, ''), COALESCE(NEW.album_id, ''))
+            ON CONFLICT DO NOTHING;
+        END IF;
+        RETURN NULL;
+    END
+    $$ LANGUAGE plpgsql`,
+	`DROP TRIGGER IF EXISTS photos_emotion_stats_dirty ON photos`,
+	`CREATE TRIGGER photos_emotion_stats_dirty
+        AFTER INSERT OR DELETE OR UPDATE OF upload_time, owner_id, album_id, emotion, confidence, moderation_status, face_count
+        ON photos FOR EACH ROW EXECUTE FUNCTION mark_emotion_stats_dirty()`,
+	// Özet kapalıyken yapılan değişiklikler kaçırılmış olabileceğinden tüm saatler yeniden hesaplanır.
+	`INSERT INTO emotion_stats_dirty
+        SELECT DISTINCT date_trunc('hour', upload_time), COALESCE(owner_id, ''), COALESCE(album_id, '')
+        FROM photos WHERE upload_time IS NOT NULL
+        ON CONFLICT DO NOTHING`,
+}
+
+// SetupEmotionRollup, saatlik duygu özetini açar veya kapatır. Kapalıyken tetikleyici kaldırılır ve
+// istatistikler doğrudan fotoğraf tablolarından hesaplanır.
+func SetupEmotionRollup(enabled bool) error {
+	if !enabled {
+		_, err := db.Exec(`DROP TRIGGER IF EXISTS photos_emotion_stats_dirty ON photos`)
+		return err
+	}
+
+	for _, stmt := range emotionRollupStatements {
+		if _, err := db.Exec(stmt); err != nil {
+			slog.Error("Duygu özeti hazırlanamadı", "error", err)
+			return err
+		}
+	}
+	return nil
+}
+
+// RefreshEmotionRollup, değiştiği işaretlenen saatlerin özetini kaynak tablolardan yeniden hesaplar ve
+// işlenen saat sayısını döndürür. Birden fazla kopya çalışıyorsa aynı anda yalnızca biri yeniler.
+func RefreshEmotionRollup(ctx context.Context) (int, error) {
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+
+	var locked bool
+	if err := tx.QueryRowContext(ctx, `SELECT pg_try_advisory_xact_lock(hashtext('emotion_stats_hourly'))`).Scan(&locked); err != nil {
+		return 0, err
+	}
+	if !locked {
+		return 0, nil
+	}
+
+	rows, err := tx.QueryContext(ctx, `DELETE FROM emotion_stats_dirty RETURNING bucket_start, owner_id, album_id`)
+	if err != nil {
+		return 0, err
+	}
+	var buckets []time.Time
+	var owners, albums []string
+	for rows.Next() {
+		var bucket time.Time
+		var owner, album string
+		if err := rows.Scan(&bucket, &owner, &album); err != nil {
+			rows.Close()
+			return 0, err
+		}
+		buckets = append(buckets, bucket)
+		owners = append(owners, owner)
+		albums = append(albums, album)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return 0, err
+	}
+	if len(buckets) == 0 {
+		return 0, nil
+	}
+
+	_, err = tx.ExecContext(ctx, `DELETE FROM emotion_stats_hourly h
+                                  USING unnest($1::timestamp[], $2::text[], $3::text[]) AS d(bucket_start, owner_id, album_id)
+                                  WHERE h.bucket_start = d.bucket_start AND h.owner_id = d.owner_id AND h.album_id = d.album_id`,
+		buckets, owners, albums)
+	if err != nil {
+		return 0, err
+	}
+	_, err = tx.ExecContext(ctx, `INSERT INTO emotion_stats_hourly (bucket_start, owner_id, album_id, emotion, face_count, confidence_sum)
+                                  SELECT d.bucket_start, s.owner_id, s.album_id, s.emotion, count(*), sum(s.confidence)
+                                  FROM (`+emotionFacesSQL+`) s
+                                  JOIN unnest($1::timestamp[], $2::text[], $3::text[]) AS d(bucket_start, owner_id, album_id)
+                                    ON date_trunc('hour', s.upload_time) = d.bucket_start
+                                   AND s.owner_id = d.owner_id AND s.album_id = d.album_id
+                                  GROUP BY d.bucket_start, s.owner_id, s.album_id, s.emotion`,
+		buckets, owners, albums)
+	if err != nil {
+		return 0, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, err
+	}
+	return len(buckets), nil
+}
+
+// AnalyticsServer, AnalyticsService gRPC hizmetini uygular.
+type AnalyticsServer struct {
+	UnimplementedAnalyticsServiceServer
+	rollup bool
+}
+
+// NewAnalyticsServer, yeni bir AnalyticsServer örneği oluşturur. Özet açıksa istatistikler saatlik özet
+// tablosundan okunur.
+func NewAnalyticsServer(cfg config.AnalyticsConfig) *AnalyticsServer {
+	return &AnalyticsServer{rollup: cfg.Rollup}
+}
+
+// GetEmotionStats, verilen zaman aralığındaki yüzlerin duygu dağılımını ve ortalama güvenilirliğini aralıklara
+// bölerek döndürür.
+func (a *AnalyticsServer) GetEmotionStats(ctx context.Context, req *GetEmotionStatsRequest) (*GetEmotionStatsResponse, error) {
+	bucket, ok := statsBucketUnits[req.Bucket]
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "bilinmeyen aralık türü: %v", req.Bucket)
+	}
+	if req.StartTime <= 0 || req.EndTime <= req.StartTime {
+		return nil, status.Error(codes.InvalidArgument, "start_time, end_time değerinden önce olmalıdır")
+	}
+	start, end := time.Unix(req.StartTime, 0).UTC(), time.Unix(req.EndTime, 0).UTC()
+	if end.Sub(start)/bucket.duration > maxStatsBuckets {
+		return nil, status.Errorf(codes.InvalidArgument, "en fazla %d aralık istenebilir; daha geniş bir aralık türü seçin", maxStatsBuckets)
+	}
+
+	var args []any
+	arg := func(v any) string {
+		args = append(args, v)
+		return "$" + strconv.Itoa(len(args))
+	}
+
+	unit := arg(bucket.unit)
+	var query string
+	var conds []string
+	if a.rollup {
+		// Özet saatlik olduğundan aralık sınırları saat başına yuvarlanır.
+		conds = append(conds, "bucket_start >= date_trunc('hour', "+arg(start)+"::timestamp)",
+			"bucket_start < date_trunc('hour', "+arg(end)+"::timestamp)")
+		query = `SELECT date_trunc(` + unit + `, bucket_start) AS b, emotion, sum(face_count), sum(confidence_sum) / sum(face_count)
+            FROM emotion_stats_hourly`
+	} else {
+		conds = append(conds, "upload_time >= "+arg(start), "upload_time < "+arg(end))
+		query = `SELECT date_trunc(` + unit + `, upload_time) AS b, emotion, count(*), avg(confidence)
+            FROM (` + emotionFacesSQL + `) s`
+	}
+	if req.OwnerId != "" {
+		conds = append(conds, "owner_id = "+arg(req.OwnerId))
+	}
+	if req.AlbumId != "" {
+		conds = append(conds, "album_id = "+arg(req.AlbumId))
+	}
+	query += ` WHERE ` + strings.Join(conds, " AND ") + ` GROUP BY b, emotion ORDER BY b, emotion`
+
+	rows, err := db.QueryContext(ctx, query, args...)
+	if err != nil {
+		slog.ErrorContext(ctx, "Duygu istatistikleri alınamadı", "error", err)
+		return nil, fmt.Errorf("Duygu istatistikleri alınamadı: %v", err)
+	}
+	defer rows.Close()
+
+	response := &GetEmotionStatsResponse{FromRollup: a.rollup}
+	var current *EmotionStatsBucket
+	for rows.Next() {
+		var bucketStart time.Time
+		stat := &EmotionStat{}
+		if err := rows.Scan(&bucketStart, &stat.Emotion, &stat.Count, &stat.MeanConfidence); err != nil {
+			return nil, fmt.Errorf("Duygu istatistikleri alınamadı: %v", err)
+		}
+		if current == nil || current.BucketStart != bucketStart.Unix() {
+			current = &EmotionStatsBucket{BucketStart: bucketStart.Unix()}
+			response.Buckets = append(response.Buckets, current)
+		}
+		current.Emotions = append(current.Emotions, stat)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("Duygu istatistikleri alınamadı: %v", err)
+	}
+	return response, nil
+}
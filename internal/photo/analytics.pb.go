@@ -0,0 +1,491 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.32.0
+// 	protoc        v4.25.2
+// source: proto/analytics.proto
+
+package photo
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// StatsBucket, istatistiklerin hangi zaman aralıklarında gruplanacağını belirler. Aralıklar UTC'dir;
+// haftalar pazartesi başlar.
+type StatsBucket int32
+
+const (
+	StatsBucket_STATS_BUCKET_DAY   StatsBucket = 0
+	StatsBucket_STATS_BUCKET_HOUR  StatsBucket = 1
+	StatsBucket_STATS_BUCKET_WEEK  StatsBucket = 2
+	StatsBucket_STATS_BUCKET_MONTH StatsBucket = 3
+)
+
+// Enum value maps for StatsBucket.
+var (
+	StatsBucket_name = map[int32]string{
+		0: "STATS_BUCKET_DAY",
+		1: "STATS_BUCKET_HOUR",
+		2: "STATS_BUCKET_WEEK",
+		3: "STATS_BUCKET_MONTH",
+	}
+	StatsBucket_value = map[string]int32{
+		"STATS_BUCKET_DAY":   0,
+		"STATS_BUCKET_HOUR":  1,
+		"STATS_BUCKET_WEEK":  2,
+		"STATS_BUCKET_MONTH": 3,
+	}
+)
+
+func (x StatsBucket) Enum() *StatsBucket {
+	p := new(StatsBucket)
+	*p = x
+	return p
+}
+
+func (x StatsBucket) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (StatsBucket) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_analytics_proto_enumTypes[0].Descriptor()
+}
+
+func (StatsBucket) Type() protoreflect.EnumType {
+	return &file_proto_analytics_proto_enumTypes[0]
+}
+
+func (x StatsBucket) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use StatsBucket.Descriptor instead.
+func (StatsBucket) EnumDescriptor() ([]byte, []int) {
+	return file_proto_analytics_proto_rawDescGZIP(), []int{0}
+}
+
+type GetEmotionStatsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Bucket    StatsBucket `protobuf:"varint,1,opt,name=bucket,proto3,enum=photo.StatsBucket" json:"bucket,omitempty"`
+	StartTime int64       `protobuf:"varint,2,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"` // Unix saniye, dahil
+	EndTime   int64       `protobuf:"varint,3,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`       // Unix saniye, hariç
+	OwnerId   string      `protobuf:"bytes,4,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`        // Boşsa tüm sahipler
+	AlbumId   string      `protobuf:"bytes,5,opt,name=album_id,json=albumId,proto3" json:"album_id,omitempty"`        // Boşsa tüm albümler
+}
+
+func (x *GetEmotionStatsRequest) Reset() {
+	*x = GetEmotionStatsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_analytics_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetEmotionStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEmotionStatsRequest) ProtoMessage() {}
+
+func (x *GetEmotionStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_analytics_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEmotionStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetEmotionStatsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_analytics_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GetEmotionStatsRequest) GetBucket() StatsBucket {
+	if x != nil {
+		return x.Bucket
+	}
+	return StatsBucket_STATS_BUCKET_DAY
+}
+
+func (x *GetEmotionStatsRequest) GetStartTime() int64 {
+	if x != nil {
+		return x.StartTime
+	}
+	return 0
+}
+
+func (x *GetEmotionStatsRequest) GetEndTime() int64 {
+	if x != nil {
+		return x.EndTime
+	}
+	return 0
+}
+
+func (x *GetEmotionStatsRequest) GetOwnerId() string {
+	if x != nil {
+		return x.OwnerId
+	}
+	return ""
+}
+
+func (x *GetEmotionStatsRequest) GetAlbumId() string {
+	if x != nil {
+		return x.AlbumId
+	}
+	return ""
+}
+
+type EmotionStat struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Emotion        string  `protobuf:"bytes,1,opt,name=emotion,proto3" json:"emotion,omitempty"`
+	Count          int64   `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"` // Bu duyguyu taşıyan yüz sayısı
+	MeanConfidence float64 `protobuf:"fixed64,3,opt,name=mean_confidence,json=meanConfidence,proto3" json:"mean_confidence,omitempty"`
+}
+
+func (x *EmotionStat) Reset() {
+	*x = EmotionStat{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_analytics_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EmotionStat) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EmotionStat) ProtoMessage() {}
+
+func (x *EmotionStat) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_analytics_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EmotionStat.ProtoReflect.Descriptor instead.
+func (*EmotionStat) Descriptor() ([]byte, []int) {
+	return file_proto_analytics_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *EmotionStat) GetEmotion() string {
+	if x != nil {
+		return x.Emotion
+	}
+	return ""
+}
+
+func (x *EmotionStat) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *EmotionStat) GetMeanConfidence() float64 {
+	if x != nil {
+		return x.MeanConfidence
+	}
+	return 0
+}
+
+type EmotionStatsBucket struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BucketStart int64          `protobuf:"varint,1,opt,name=bucket_start,json=bucketStart,proto3" json:"bucket_start,omitempty"` // Unix saniye
+	Emotions    []*EmotionStat `protobuf:"bytes,2,rep,name=emotions,proto3" json:"emotions,omitempty"`                           // Duygu adına göre sıralı
+}
+
+func (x *EmotionStatsBucket) Reset() {
+	*x = EmotionStatsBucket{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_analytics_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EmotionStatsBucket) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EmotionStatsBucket) ProtoMessage() {}
+
+func (x *EmotionStatsBucket) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_analytics_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EmotionStatsBucket.ProtoReflect.Descriptor instead.
+func (*EmotionStatsBucket) Descriptor() ([]byte, []int) {
+	return file_proto_analytics_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *EmotionStatsBucket) GetBucketStart() int64 {
+	if x != nil {
+		return x.BucketStart
+	}
+	return 0
+}
+
+func (x *EmotionStatsBucket) GetEmotions() []*EmotionStat {
+	if x != nil {
+		return x.Emotions
+	}
+	return nil
+}
+
+type GetEmotionStatsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Buckets    []*EmotionStatsBucket `protobuf:"bytes,1,rep,name=buckets,proto3" json:"buckets,omitempty"`                          // Zamana göre sıralı; yüz olmayan aralıklar atlanır
+	FromRollup bool                  `protobuf:"varint,2,opt,name=from_rollup,json=fromRollup,proto3" json:"from_rollup,omitempty"` // Sonuç saatlik özet tablosundan hesaplandıysa true; aralık saat başına yuvarlanır
+}
+
+func (x *GetEmotionStatsResponse) Reset() {
+	*x = GetEmotionStatsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_analytics_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetEmotionStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEmotionStatsResponse) ProtoMessage() {}
+
+func (x *GetEmotionStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_analytics_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEmotionStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetEmotionStatsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_analytics_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetEmotionStatsResponse) GetBuckets() []*EmotionStatsBucket {
+	if x != nil {
+		return x.Buckets
+	}
+	return nil
+}
+
+func (x *GetEmotionStatsResponse) GetFromRollup() bool {
+	if x != nil {
+		return x.FromRollup
+	}
+	return false
+}
+
+var File_proto_analytics_proto protoreflect.FileDescriptor
+
+var file_proto_analytics_proto_rawDesc = []byte{
+	0x0a, 0x15, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63,
+	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x05, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x22, 0xb4,
+	0x01, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x45, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61,
+	0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2a, 0x0a, 0x06, 0x62, 0x75, 0x63,
+	0x6b, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x70, 0x68, 0x6f, 0x74,
+	0x6f, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x06, 0x62,
+	0x75, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x54, 0x69, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12,
+	0x19, 0x0a, 0x08, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x6c,
+	0x62, 0x75, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x6c,
+	0x62, 0x75, 0x6d, 0x49, 0x64, 0x22, 0x66, 0x0a, 0x0b, 0x45, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e,
+	0x53, 0x74, 0x61, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x65, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14,
+	0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x6d, 0x65, 0x61, 0x6e, 0x5f, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0e, 0x6d,
+	0x65, 0x61, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x22, 0x67, 0x0a,
+	0x12, 0x45, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x42, 0x75, 0x63,
+	0x6b, 0x65, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x5f, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x62, 0x75, 0x63, 0x6b, 0x65,
+	0x74, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x2e, 0x0a, 0x08, 0x65, 0x6d, 0x6f, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x68, 0x6f, 0x74, 0x6f,
+	0x2e, 0x45, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x52, 0x08, 0x65, 0x6d,
+	0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x6f, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x45, 0x6d, 0x6f,
+	0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x33, 0x0a, 0x07, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x6d, 0x6f, 0x74, 0x69,
+	0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x07, 0x62,
+	0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x72,
+	0x6f, 0x6c, 0x6c, 0x75, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x66, 0x72, 0x6f,
+	0x6d, 0x52, 0x6f, 0x6c, 0x6c, 0x75, 0x70, 0x2a, 0x69, 0x0a, 0x0b, 0x53, 0x74, 0x61, 0x74, 0x73,
+	0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x14, 0x0a, 0x10, 0x53, 0x54, 0x41, 0x54, 0x53, 0x5f,
+	0x42, 0x55, 0x43, 0x4b, 0x45, 0x54, 0x5f, 0x44, 0x41, 0x59, 0x10, 0x00, 0x12, 0x15, 0x0a, 0x11,
+	0x53, 0x54, 0x41, 0x54, 0x53, 0x5f, 0x42, 0x55, 0x43, 0x4b, 0x45, 0x54, 0x5f, 0x48, 0x4f, 0x55,
+	0x52, 0x10, 0x01, 0x12, 0x15, 0x0a, 0x11, 0x53, 0x54, 0x41, 0x54, 0x53, 0x5f, 0x42, 0x55, 0x43,
+	0x4b, 0x45, 0x54, 0x5f, 0x57, 0x45, 0x45, 0x4b, 0x10, 0x02, 0x12, 0x16, 0x0a, 0x12, 0x53, 0x54,
+	0x41, 0x54, 0x53, 0x5f, 0x42, 0x55, 0x43, 0x4b, 0x45, 0x54, 0x5f, 0x4d, 0x4f, 0x4e, 0x54, 0x48,
+	0x10, 0x03, 0x32, 0x64, 0x0a, 0x10, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x50, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x45, 0x6d, 0x6f,
+	0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x1d, 0x2e, 0x70, 0x68, 0x6f, 0x74,
+	0x6f, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x70, 0x68, 0x6f, 0x74, 0x6f,
+	0x2e, 0x47, 0x65, 0x74, 0x45, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x1b, 0x5a, 0x19, 0x6d, 0x79, 0x70, 0x68,
+	0x6f, 0x74, 0x6f, 0x61, 0x70, 0x70, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f,
+	0x70, 0x68, 0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_proto_analytics_proto_rawDescOnce sync.Once
+	file_proto_analytics_proto_rawDescData = file_proto_analytics_proto_rawDesc
+)
+
+func file_proto_analytics_proto_rawDescGZIP() []byte {
+	file_proto_analytics_proto_rawDescOnce.Do(func() {
+		file_proto_analytics_proto_rawDescData = protoimpl.X.CompressGZIP(file_proto_analytics_proto_rawDescData)
+	})
+	return file_proto_analytics_proto_rawDescData
+}
+
+var file_proto_analytics_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_proto_analytics_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_proto_analytics_proto_goTypes = []interface{}{
+	(StatsBucket)(0),                // 0: photo.StatsBucket
+	(*GetEmotionStatsRequest)(nil),  // 1: photo.GetEmotionStatsRequest
+	(*EmotionStat)(nil),             // 2: photo.EmotionStat
+	(*EmotionStatsBucket)(nil),      // 3: photo.EmotionStatsBucket
+	(*GetEmotionStatsResponse)(nil), // 4: photo.GetEmotionStatsResponse
+}
+var file_proto_analytics_proto_depIdxs = []int32{
+	0, // 0: photo.GetEmotionStatsRequest.bucket:type_name -> photo.StatsBucket
+	2, // 1: photo.EmotionStatsBucket.emotions:type_name -> photo.EmotionStat
+	3, // 2: photo.GetEmotionStatsResponse.buckets:type_name -> photo.EmotionStatsBucket
+	1, // 3: photo.AnalyticsService.GetEmotionStats:input_type -> photo.GetEmotionStatsRequest
+	4, // 4: photo.AnalyticsService.GetEmotionStats:output_type -> photo.GetEmotionStatsResponse
+	4, // [4:5] is the sub-list for method output_type
+	3, // [3:4] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_proto_analytics_proto_init() }
+func file_proto_analytics_proto_init() {
+	if File_proto_analytics_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_proto_analytics_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetEmotionStatsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_analytics_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EmotionStat); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_analytics_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EmotionStatsBucket); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_analytics_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetEmotionStatsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_proto_analytics_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_proto_analytics_proto_goTypes,
+		DependencyIndexes: file_proto_analytics_proto_depIdxs,
+		EnumInfos:         file_proto_analytics_proto_enumTypes,
+		MessageInfos:      file_proto_analytics_proto_msgTypes,
+	}.Build()
+	File_proto_analytics_proto = out.File
+	file_proto_analytics_proto_rawDesc = nil
+	file_proto_analytics_proto_goTypes = nil
+	file_proto_analytics_proto_depIdxs = nil
+}
@@ -0,0 +1,36 @@
+package photo
+
+import (
+	"context"
+	"testing"
+
+	"myphotoapp/config"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestGetEmotionStatsValidation(t *testing.T) {
+	// Geçersiz istekler veritabanına ulaşmadan reddedilir.
+	a := NewAnalyticsServer(config.AnalyticsConfig{})
+	const day = 24 * 60 * 60
+	start := int64(1700000000)
+
+	tests := []struct {
+		name string
+		req  *GetEmotionStatsRequest
+	}{
+		{"bilinmeyen aralık türü", &GetEmotionStatsRequest{Bucket: StatsBucket(9), StartTime: start, EndTime: start + day}},
+		{"başlangıç yok", &GetEmotionStatsRequest{EndTime: start}},
+		{"ters aralık", &GetEmotionStatsRequest{StartTime: start, EndTime: start - day}},
+		{"boş aralık", &GetEmotionStatsRequest{StartTime: start, EndTime: start}},
+		{"çok fazla saat", &GetEmotionStatsRequest{Bucket: StatsBucket_STATS_BUCKET_HOUR, StartTime: start, EndTime: start + 100*day}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := a.GetEmotionStats(context.Background(), tt.req); status.Code(err) != codes.InvalidArgument {
+				t.Errorf("GetEmotionStats = %v, want InvalidArgument", err)
+			}
+		})
+	}
+}
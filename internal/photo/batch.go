@@ -0,0 +1,94 @@
+package photo
+
+import (
+	"context"
+	"sync"
+
+	"myphotoapp/config"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	defaultBatchMaxImages   = 100 // Yapılandırma verilmediğinde tek istekte kabul edilen en fazla fotoğraf
+	defaultBatchConcurrency = 4   // Yapılandırma verilmediğinde aynı anda işlenen en fazla fotoğraf
+)
+
+// batchLimits, toplu yükleme sınırlarını varsayılanlarla tamamlar.
+func batchLimits(cfg config.BatchConfig) config.BatchConfig {
+	if cfg.MaxImages <= 0 {
+		cfg.MaxImages = defaultBatchMaxImages
+	}
+	if cfg.Concurrency <= 0 {
+		cfg.Concurrency = defaultBatchConcurrency
+	}
+	return cfg
+}
+
+// BatchUploadImages, fotoğrafları sınırlı eşzamanlılıkla UploadImage üzerinden yükler. Bir fotoğrafın hatası,
+// UploadImage istek sınırına takılması da dahil, diğerlerini etkilemez; her fotoğrafın sonucu istekteki
+// sırasıyla döndürülür. İstek iptal edilirse henüz başlamamış fotoğraflar iptal koduyla döner.
+func (s *PhotoService) BatchUploadImages(ctx context.Context, req *BatchUploadImagesRequest) (*BatchUploadImagesResponse, error) {
+	if len(req.Images) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "en az bir fotoğraf gönderilmelidir")
+	}
+	if len(req.Images) > s.batch.MaxImages {
+		return nil, status.Errorf(codes.InvalidArgument, "tek istekte en fazla %d fotoğraf yüklenebilir", s.batch.MaxImages)
+	}
+
+	results := make([]*BatchUploadResult, len(req.Images))
+	sem := make(chan struct{}, s.batch.Concurrency)
+	var wg sync.WaitGroup
+	for i, image := range req.Images {
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+			results[i] = batchUploadResult(nil, status.FromContextError(ctx.Err()).Err())
+			continue
+		}
+
+		wg.Add(1)
+		go func(i int, image *UploadedImage) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			results[i] = batchUploadResult(s.uploadBatchItem(ctx, image))
+		}(i, image)
+	}
+	wg.Wait()
+
+	response := &BatchUploadImagesResponse{Results: results}
+	for _, result := range results {
+		if result.Code == int32(codes.OK) {
+			response.Succeeded++
+		} else {
+			response.Failed++
+		}
+	}
+	return response, nil
+}
+
+// uploadBatchItem, toplu yüklemedeki tek bir fotoğrafı yükler.
+func (s *PhotoService) uploadBatchItem(ctx context.Context, image *UploadedImage) (*UploadedImage, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, status.FromContextError(err).Err()
+	}
+	if image == nil || image.Url == "" {
+		return nil, status.Error(codes.InvalidArgument, "fotoğraf URL'si boş olamaz")
+	}
+	// Her fotoğraf, UploadImage ile tek tek yüklenmiş gibi istemcinin UploadImage sınırından düşülür.
+	if err := limitItem(ctx, PhotoService_UploadImage_FullMethodName); err != nil {
+		return nil, err
+	}
+	return s.UploadImage(ctx, image)
+}
+
+// batchUploadResult, bir yükleme sonucunu toplu yanıt öğesine çevirir. gRPC durumu taşımayan hatalar
+// Unknown koduyla döner.
+func batchUploadResult(image *UploadedImage, err error) *BatchUploadResult {
+	if err != nil {
+		st := status.Convert(err)
+		return &BatchUploadResult{Code: int32(st.Code()), Message: st.Message()}
+	}
+	return &BatchUploadResult{Code: int32(codes.OK), Image: image}
+}
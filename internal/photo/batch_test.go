@@ -0,0 +1,61 @@
+package photo
+
+import (
+	"context"
+	"testing"
+
+	"myphotoapp/config"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestBatchUploadImagesLimits(t *testing.T) {
+	s := &PhotoService{batch: batchLimits(config.BatchConfig{MaxImages: 2})}
+	ctx := peerContext("203.0.113.7")
+
+	if _, err := s.BatchUploadImages(ctx, &BatchUploadImagesRequest{}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("boş istek = %v, want InvalidArgument", err)
+	}
+	req := &BatchUploadImagesRequest{Images: []*UploadedImage{{}, {}, {}}}
+	if _, err := s.BatchUploadImages(ctx, req); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("sınırı aşan istek = %v, want InvalidArgument", err)
+	}
+}
+
+func TestBatchUploadImagesPerItemResults(t *testing.T) {
+	// Geçersiz öğeler Vision API çağrılmadan reddedilir; biri diğerlerini durdurmaz ve sıra korunur.
+	s := &PhotoService{batch: batchLimits(config.BatchConfig{Concurrency: 2})}
+	req := &BatchUploadImagesRequest{Images: []*UploadedImage{
+		nil,
+		{Url: "file:///etc/passwd"},
+		{Url: "https://example.com/a.jpg", CapturedAt: -1},
+	}}
+
+	resp, err := s.BatchUploadImages(peerContext("203.0.113.7"), req)
+	if err != nil {
+		t.Fatalf("BatchUploadImages: %v", err)
+	}
+	if len(resp.Results) != len(req.Images) || resp.Failed != 3 || resp.Succeeded != 0 {
+		t.Fatalf("yanıt = %v, want 3 başarısız sonuç", resp)
+	}
+	for i, result := range resp.Results {
+		if codes.Code(result.Code) != codes.InvalidArgument || result.Message == "" || result.Image != nil {
+			t.Errorf("sonuç %d = %v, want InvalidArgument", i, result)
+		}
+	}
+}
+
+func TestBatchUploadImagesCanceled(t *testing.T) {
+	s := &PhotoService{batch: batchLimits(config.BatchConfig{})}
+	ctx, cancel := context.WithCancel(peerContext("203.0.113.7"))
+	cancel()
+
+	resp, err := s.BatchUploadImages(ctx, &BatchUploadImagesRequest{Images: []*UploadedImage{{Url: "https://example.com/a.jpg"}}})
+	if err != nil {
+		t.Fatalf("BatchUploadImages: %v", err)
+	}
+	if code := codes.Code(resp.Results[0].Code); code != codes.Canceled {
+		t.Errorf("iptal edilen öğenin kodu = %s, want Canceled", code)
+	}
+}
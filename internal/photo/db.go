@@ -1,13 +1,18 @@
 package photo
 
 import (
+	"context"
 	"database/sql"
+	"encoding/json"
 	"errors"
 	"fmt"
-	"log"
+	"log/slog"
+	"strconv"
+	"strings"
 	"time"
 
-	_ "github.com/jackc/pgx/v4/stdlib"
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/stdlib"
 )
 
 const (
@@ -25,19 +30,20 @@ func InitDB() error {
 	connStr := fmt.Sprintf("host=%s port=%d dbname=%s user=%s password=%s sslmode=disable",
 		dbHost, dbPort, dbName, dbUser, dbPassword)
 
-	var err error
-	db, err = sql.Open("pgx", connStr)
+	// Sürücü her sorguyu dbQueryTracer'a bildirir; sorgular isteğin izinde ayrı span'ler olarak görünür.
+	pgxConfig, err := pgx.ParseConfig(connStr)
 	if err != nil {
-		log.Fatalf("Veritabanına bağlanılamadı: %v", err)
-		return err
+		return fmt.Errorf("veritabanına bağlanılamadı: %w", err)
 	}
+	pgxConfig.Logger = dbQueryTracer{}
+	pgxConfig.LogLevel = pgx.LogLevelInfo
+	db = stdlib.OpenDB(*pgxConfig)
 
 	if err := db.Ping(); err != nil {
-		log.Fatalf("Veritabanına bağlanılamadı: %v", err)
-		return err
+		return fmt.Errorf("veritabanına bağlanılamadı: %w", err)
 	}
 
-	log.Printf("Veritabanına bağlantı başarılı")
+	slog.Info("Veritabanına bağlantı başarılı")
 	return nil
 }
 
@@ -49,57 +55,660 @@ func CloseDB() error {
 	return nil
 }
 
-// CreatePhotoTable, "photos" adında bir tablo oluşturur.
-func CreatePhotoTable() error {
-	_, err := db.Exec(`CREATE TABLE IF NOT EXISTS photos (
+// PingDB, veritabanı bağlantısının çalıştığını doğrular.
+func PingDB(ctx context.Context) error {
+	if db == nil {
+		return errors.New("veritabanı bağlantısı başlatılmadı")
+	}
+	return db.PingContext(ctx)
+}
+
+// schemaStatements, CreatePhotoTable tarafından sırayla çalıştırılan şema komutlarıdır.
+var schemaStatements = []string{
+	`CREATE TABLE IF NOT EXISTS photos (
         id SERIAL PRIMARY KEY,
         url TEXT,
         emotion TEXT,
         confidence FLOAT,
         upload_time TIMESTAMP
-    )`)
+    )`,
+	`CREATE TABLE IF NOT EXISTS photo_labels (
+        photo_id INTEGER NOT NULL REFERENCES photos(id) ON DELETE CASCADE,
+        description TEXT NOT NULL,
+        score FLOAT NOT NULL,
+        mid TEXT,
+        PRIMARY KEY (photo_id, description)
+    )`,
+	`CREATE INDEX IF NOT EXISTS photo_labels_description_idx ON photo_labels (lower(description))`,
+	`CREATE TABLE IF NOT EXISTS photo_tags (
+        photo_id INTEGER NOT NULL REFERENCES photos(id) ON DELETE CASCADE,
+        tag TEXT NOT NULL,
+        created_at TIMESTAMP NOT NULL,
+        PRIMARY KEY (photo_id, tag)
+    )`,
+	`CREATE INDEX IF NOT EXISTS photo_tags_tag_idx ON photo_tags (tag)`,
+	`ALTER TABLE photos ADD COLUMN IF NOT EXISTS owner_id TEXT`,
+	`ALTER TABLE photos ADD COLUMN IF NOT EXISTS caption TEXT`,
+	`ALTER TABLE photos ADD COLUMN IF NOT EXISTS search_text TEXT`,
+	`ALTER TABLE photos ADD COLUMN IF NOT EXISTS search_vector tsvector`,
+	`CREATE INDEX IF NOT EXISTS photos_search_vector_idx ON photos USING GIN (search_vector)`,
+	`CREATE INDEX IF NOT EXISTS photos_owner_id_idx ON photos (owner_id)`,
+	`CREATE TABLE IF NOT EXISTS photo_text (
+        photo_id INTEGER PRIMARY KEY REFERENCES photos(id) ON DELETE CASCADE,
+        full_text TEXT NOT NULL,
+        language TEXT
+    )`,
+	`CREATE TABLE IF NOT EXISTS photo_text_blocks (
+        photo_id INTEGER NOT NULL REFERENCES photo_text(photo_id) ON DELETE CASCADE,
+        block_index INTEGER NOT NULL,
+        text TEXT NOT NULL,
+        confidence FLOAT NOT NULL,
+        bounding_box JSONB NOT NULL,
+        PRIMARY KEY (photo_id, block_index)
+    )`,
+	`ALTER TABLE photos ADD COLUMN IF NOT EXISTS moderation_status TEXT NOT NULL DEFAULT 'ACCEPTED'`,
+	`ALTER TABLE photos ADD COLUMN IF NOT EXISTS moderation_reason TEXT`,
+	`ALTER TABLE photos ADD COLUMN IF NOT EXISTS safe_search JSONB`,
+	`CREATE INDEX IF NOT EXISTS photos_moderation_status_idx ON photos (moderation_status, upload_time)`,
+	`CREATE TABLE IF NOT EXISTS moderation_decisions (
+        id SERIAL PRIMARY KEY,
+        photo_id INTEGER NOT NULL REFERENCES photos(id) ON DELETE CASCADE,
+        decision TEXT NOT NULL,
+        moderator_id TEXT NOT NULL,
+        reason TEXT,
+        decided_at TIMESTAMP NOT NULL
+    )`,
+	`CREATE INDEX IF NOT EXISTS moderation_decisions_photo_id_idx ON moderation_decisions (photo_id)`,
+	`ALTER TABLE photos ADD COLUMN IF NOT EXISTS latitude FLOAT`,
+	`ALTER TABLE photos ADD COLUMN IF NOT EXISTS longitude FLOAT`,
+	`ALTER TABLE photos ADD COLUMN IF NOT EXISTS location_source TEXT`,
+	`ALTER TABLE photos ADD COLUMN IF NOT EXISTS location_name TEXT`,
+	// photo_locations, akışta görünen fotoğrafların coğrafi dizinidir (bkz. PostgresLocationRepository).
+	`CREATE TABLE IF NOT EXISTS photo_locations (
+        photo_id INTEGER PRIMARY KEY REFERENCES photos(id) ON DELETE CASCADE,
+        latitude FLOAT NOT NULL,
+        longitude FLOAT NOT NULL
+    )`,
+	`CREATE INDEX IF NOT EXISTS photo_locations_lat_lng_idx ON photo_locations (latitude, longitude)`,
+	// Dizine yazılamamış konumları tamamlar.
+	`INSERT INTO photo_locations (photo_id, latitude, longitude)
+        SELECT id, latitude, longitude FROM photos
+        WHERE latitude IS NOT NULL AND longitude IS NOT NULL AND moderation_status = 'ACCEPTED'
+        ON CONFLICT (photo_id) DO NOTHING`,
+	`CREATE TABLE IF NOT EXISTS photo_faces (
+        photo_id INTEGER NOT NULL REFERENCES photos(id) ON DELETE CASCADE,
+        face_index INTEGER NOT NULL,
+        emotion TEXT NOT NULL,
+        confidence FLOAT NOT NULL,
+        detection_confidence FLOAT NOT NULL,
+        joy SMALLINT NOT NULL,
+        sorrow SMALLINT NOT NULL,
+        anger SMALLINT NOT NULL,
+        surprise SMALLINT NOT NULL,
+        blurred SMALLINT NOT NULL,
+        headwear SMALLINT NOT NULL,
+        under_exposed SMALLINT NOT NULL,
+        PRIMARY KEY (photo_id, face_index)
+    )`,
+	`ALTER TABLE photos ADD COLUMN IF NOT EXISTS emotion_rules_version TEXT`,
+	`ALTER TABLE photo_faces ADD COLUMN IF NOT EXISTS geometry JSONB`,
+	`ALTER TABLE photos ADD COLUMN IF NOT EXISTS image_width INTEGER`,
+	`ALTER TABLE photos ADD COLUMN IF NOT EXISTS image_height INTEGER`,
+	`CREATE TABLE IF NOT EXISTS persons (
+        id SERIAL PRIMARY KEY,
+        owner_id TEXT NOT NULL,
+        name TEXT NOT NULL,
+        created_at TIMESTAMP NOT NULL
+    )`,
+	`CREATE INDEX IF NOT EXISTS persons_owner_id_idx ON persons (owner_id)`,
+	`ALTER TABLE photo_faces ADD COLUMN IF NOT EXISTS person_id INTEGER REFERENCES persons(id) ON DELETE SET NULL`,
+	`CREATE INDEX IF NOT EXISTS photo_faces_person_id_idx ON photo_faces (person_id)`,
+	`ALTER TABLE photos ADD COLUMN IF NOT EXISTS updated_at TIMESTAMP`,
+	`ALTER TABLE photos ADD COLUMN IF NOT EXISTS captured_at TIMESTAMP`,
+	`ALTER TABLE photos ADD COLUMN IF NOT EXISTS face_count INTEGER`,
+	// Yüz sayısı sütunu eklenmeden önce yüklenen fotoğrafları doldurur; yüz kaydı olmayanlarda tek duygu bir yüz sayılır.
+	`UPDATE photos p SET face_count = COALESCE(NULLIF((SELECT count(*) FROM photo_faces f WHERE f.photo_id = p.id), 0),
+                                               CASE WHEN p.emotion IS NULL THEN 0 ELSE 1 END)
+        WHERE p.face_count IS NULL`,
+	`CREATE INDEX IF NOT EXISTS photos_captured_at_idx ON photos (captured_at)`,
+	`ALTER TABLE photos ADD COLUMN IF NOT EXISTS album_id TEXT`,
+	`CREATE INDEX IF NOT EXISTS photos_album_id_idx ON photos (album_id)`,
+	// feed_events, WatchFeed için fotoğraf değişikliklerinin kaydıdır (bkz. FeedBroadcaster). tx, kaydı yazan
+	// işlemin kimliğidir; okuyucular yalnızca tamamlanmış işlemlerin kayıtlarını (tx, seq) sırasıyla okur.
+	`CREATE TABLE IF NOT EXISTS feed_events (
+        seq BIGSERIAL PRIMARY KEY,
+        tx BIGINT NOT NULL DEFAULT txid_current(),
+        photo_id INTEGER NOT NULL,
+        op TEXT NOT NULL,
+        was_visible BOOLEAN NOT NULL,
+        occurred_at TIMESTAMP NOT NULL DEFAULT (now() AT TIME ZONE 'UTC')
+    )`,
+	`CREATE INDEX IF NOT EXISTS feed_events_position_idx ON feed_events (tx, seq)`,
+	`CREATE INDEX IF NOT EXISTS feed_events_occurred_at_idx ON feed_events (occurred_at)`,
+	`CREATE OR REPLACE FUNCTION record_feed_event() RETURNS trigger AS $$
+    BEGIN
+        IF TG_OP = 'INSERT' THEN
+            INSERT INTO feed_events (photo_id, op, was_visible) VALUES (NEW.id, TG_OP, FALSE);
+        ELSE
+            INSERT INTO feed_events (photo_id, op, was_visible)
+            VALUES (OLD.id, TG_OP, OLD.moderation_status IS NOT DISTINCT FROM 'ACCEPTED');
+        END IF;
+        PERFORM pg_notify('` + feedEventsChannel + `', '');
+        RETURN NULL;
+    END
+    $$ LANGUAGE plpgsql`,
+	`DROP TRIGGER IF EXISTS photos_feed_events ON photos`,
+	`CREATE TRIGGER photos_feed_events
+        AFTER INSERT OR DELETE OR UPDATE OF url, emotion, confidence, upload_time, owner_id, caption, moderation_status,
+            latitude, longitude, location_name, image_width, image_height, captured_at, face_count, album_id
+        ON photos FOR EACH ROW EXECUTE FUNCTION record_feed_event()`,
+	// vision_cache, VisionCache'in Postgres katmanıdır.
+	`CREATE TABLE IF NOT EXISTS vision_cache (
+        cache_key TEXT PRIMARY KEY,
+        result JSONB NOT NULL,
+        created_at TIMESTAMP NOT NULL
+    )`,
+	`CREATE INDEX IF NOT EXISTS vision_cache_created_at_idx ON vision_cache (created_at)`,
+	// vision_usage, kiracıların günlük Vision birimi kullanımıdır (bkz. VisionBudgetConfig).
+	`CREATE TABLE IF NOT EXISTS vision_usage (
+        tenant_id TEXT NOT NULL,
+        day DATE NOT NULL,
+        units INTEGER NOT NULL,
+        PRIMARY KEY (tenant_id, day)
+    )`,
+	`ALTER TABLE photos ADD COLUMN IF NOT EXISTS analysis_status TEXT NOT NULL DEFAULT 'COMPLETE'`,
+	// analysis_queue, Vision API'ye ulaşılamadığı için analizi ertelenen fotoğraflardır (bkz. ProcessAnalysisQueue).
+	`CREATE TABLE IF NOT EXISTS analysis_queue (
+        photo_id INTEGER PRIMARY KEY REFERENCES photos(id) ON DELETE CASCADE,
+        options JSONB NOT NULL,
+        attempts INTEGER NOT NULL DEFAULT 0,
+        next_attempt_at TIMESTAMP NOT NULL,
+        last_error TEXT,
+        enqueued_at TIMESTAMP NOT NULL
+    )`,
+	`CREATE INDEX IF NOT EXISTS analysis_queue_next_attempt_at_idx ON analysis_queue (next_attempt_at)`,
+	// Arama sütunları eklenmeden önce yüklenen fotoğrafları doldurur.
+	searchVectorUpdateSQL("p2.search_vector IS NULL"),
+}
+
+// photoColumns, scanPhoto'nun beklediği sırayla photos tablosundan seçilen sütunlardır.
+const photoColumns = `id, url, emotion, confidence, upload_time, COALESCE(owner_id, ''), COALESCE(caption, ''),
+        moderation_status, COALESCE(moderation_reason, ''), safe_search,
+        latitude, longitude, COALESCE(location_source, ''), COALESCE(location_name, ''),
+        COALESCE(emotion_rules_version, ''), COALESCE(image_width, 0), COALESCE(image_height, 0), captured_at,
+        COALESCE(album_id, ''), analysis_status`
+
+// marshalSafeSearch, SafeSearch sonucunu JSONB sütununa yazılacak biçime çevirir. Sonuç yoksa NULL yazılır.
+func marshalSafeSearch(safeSearch *SafeSearch) (any, error) {
+	if safeSearch == nil {
+		return nil, nil
+	}
+	data, err := json.Marshal(safeSearch)
+	if err != nil {
+		return nil, err
+	}
+	return string(data), nil
+}
+
+// emotionColumns, fotoğrafın emotion ve confidence sütunlarına yazılacak ilk yüzün duygusunu döndürür.
+// Yüz bulunmayan fotoğraflarda sütunlar NULL kalır.
+func emotionColumns(faces []*FaceAnalysis) (any, any) {
+	if len(faces) == 0 {
+		return nil, nil
+	}
+	return faces[0].Emotion, faces[0].Confidence
+}
+
+// locationColumns, bir konumu photos tablosunun konum sütunlarına çevirir. Konum yoksa hepsi NULL olur.
+func locationColumns(location *Location) (lat, lng, source, name any) {
+	if location == nil {
+		return nil, nil, nil, nil
+	}
+
+	source = strings.TrimPrefix(location.Source.String(), "LOCATION_SOURCE_")
+	if location.Name != "" {
+		name = location.Name
+	}
+	return location.Latitude, location.Longitude, source, name
+}
+
+// capturedAtColumn, çekim zamanını captured_at sütununa çevirir. Çekim zamanı yoksa NULL yazılır.
+func capturedAtColumn(capturedAt int64) any {
+	if capturedAt <= 0 {
+		return nil
+	}
+	return time.Unix(capturedAt, 0).UTC()
+}
+
+// execer, *sql.DB ve *sql.Tx türlerinin ortak Exec metodunu temsil eder.
+type execer interface {
+	Exec(query string, args ...any) (sql.Result, error)
+}
+
+// searchVectorUpdateSQL, koşulu sağlayan fotoğrafların arama metnini ve tsvector sütununu yeniden hesaplayan
+// UPDATE komutunu üretir. Açıklama ve kullanıcı etiketleri Vision etiketlerinden, Vision etiketleri de OCR
+// metninden daha yüksek ağırlık alır.
+// Çok dilli içerik olduğundan kök bulma yapmayan 'simple' yapılandırması kullanılır.
+func searchVectorUpdateSQL(cond string) string {
+	return `UPDATE photos p SET search_text = d.text, search_vector = d.vector
+        FROM (SELECT p2.id,
+                     concat_ws(' ', p2.caption, t.tags, l.labels, o.full_text) AS text,
+                     setweight(to_tsvector('simple', coalesce(p2.caption, '')), 'A') ||
+                     setweight(to_tsvector('simple', coalesce(t.tags, '')), 'A') ||
+                     setweight(to_tsvector('simple', coalesce(l.labels, '')), 'B') ||
+                     setweight(to_tsvector('simple', coalesce(o.full_text, '')), 'C') AS vector
+              FROM photos p2
+              LEFT JOIN LATERAL (SELECT string_agg(tag, ' ') AS tags FROM photo_tags WHERE photo_id = p2.id) t ON true
+              LEFT JOIN LATERAL (SELECT string_agg(description, ' ') AS labels FROM photo_labels WHERE photo_id = p2.id) l ON true
+              LEFT JOIN photo_text o ON o.photo_id = p2.id
+              WHERE ` + cond + `) d
+        WHERE p.id = d.id`
+}
+
+// refreshSearchVector, bir fotoğrafın arama sütunlarını yazma işlemiyle aynı işlem içinde günceller.
+func refreshSearchVector(ex execer, photoID any) error {
+	_, err := ex.Exec(searchVectorUpdateSQL("p2.id = $1"), photoID)
+	return err
+}
+
+// CreatePhotoTable, "photos" adında bir tablo ve ona bağlı etiket tablolarını oluşturur.
+func CreatePhotoTable() error {
+	for _, stmt := range schemaStatements {
+		if _, err := db.Exec(stmt); err != nil {
+			slog.Error("Tablo oluşturulamadı", "error", err)
+			return err
+		}
+	}
+
+	slog.Info("Tablo oluşturuldu")
+	return nil
+}
+
+// InsertPhoto, fotoğraf bilgilerini ve etiketlerini veritabanına ekler ve oluşan ID'yi photo.Id alanına yazar.
+func InsertPhoto(ctx context.Context, photo *UploadedImage) error {
+	return insertPhoto(ctx, photo, nil)
+}
 
+// InsertPendingPhoto, analizi yapılamamış bir fotoğrafı ekler ve verilen seçeneklerle analiz kuyruğuna alır.
+func InsertPendingPhoto(ctx context.Context, photo *UploadedImage, opts AnalyzeOptions) error {
+	return insertPhoto(ctx, photo, &opts)
+}
+
+// insertPhoto, fotoğrafı ayrıntılarıyla tek işlemde ekler. pending verilmişse fotoğraf yüzsüz eklenir ve
+// analiz kuyruğuna alınır.
+func insertPhoto(ctx context.Context, photo *UploadedImage, pending *AnalyzeOptions) error {
+	tx, err := db.BeginTx(ctx, nil)
 	if err != nil {
-		log.Printf("Tablo oluşturulamadı: %v", err)
+		slog.ErrorContext(ctx, "Fotoğraf eklenemedi", "error", err)
 		return err
 	}
+	defer tx.Rollback()
 
-	log.Printf("Tablo oluşturuldu")
+	safeSearch, err := marshalSafeSearch(photo.SafeSearch)
+	if err != nil {
+		return err
+	}
+
+	emotion, confidence := emotionColumns(photo.FaceAnalysis)
+	lat, lng, source, name := locationColumns(photo.Location)
+
+	var id int64
+	err = tx.QueryRowContext(ctx, `INSERT INTO photos (url, emotion, confidence, upload_time, owner_id, caption,
+                                             moderation_status, moderation_reason, safe_search,
+                                             latitude, longitude, location_source, location_name, emotion_rules_version,
+                                             image_width, image_height, captured_at, face_count, album_id, analysis_status)
+                          VALUES ($1, $2, $3, $4, NULLIF($5, ''), NULLIF($6, ''), $7, NULLIF($8, ''), $9,
+                                  $10, $11, $12, $13, NULLIF($14, ''), NULLIF($15, 0), NULLIF($16, 0), $17, $18,
+                                  NULLIF($19, ''), $20) RETURNING id`,
+		photo.Url, emotion, confidence, time.Unix(photo.UploadTime, 0).UTC(),
+		photo.OwnerId, photo.Caption, moderationStatusToDB(photo.ModerationStatus), photo.ModerationReason, safeSearch,
+		lat, lng, source, name, photo.EmotionRulesVersion, photo.ImageWidth, photo.ImageHeight,
+		capturedAtColumn(photo.CapturedAt), len(photo.FaceAnalysis), photo.AlbumId,
+		analysisStatusToDB(photo.AnalysisStatus)).Scan(&id)
+	if err != nil {
+		slog.ErrorContext(ctx, "Fotoğraf eklenemedi", "error", err)
+		return err
+	}
+
+	if pending != nil {
+		if err := enqueueAnalysis(tx, id, *pending); err != nil {
+			slog.ErrorContext(ctx, "Fotoğraf analiz kuyruğuna alınamadı", "error", err)
+			return err
+		}
+	}
+
+	// Otomatik karantina kararı moderatörlerin görebilmesi için geçmişe yazılır.
+	if photo.ModerationStatus == ModerationStatus_MODERATION_QUARANTINED {
+		err := insertModerationDecision(tx, id, moderationQuarantined, systemModerator, photo.ModerationReason)
+		if err != nil {
+			slog.ErrorContext(ctx, "Denetim kararı kaydedilemedi", "error", err)
+			return err
+		}
+	}
+
+	if err := insertFaces(tx, id, photo.FaceAnalysis); err != nil {
+		slog.ErrorContext(ctx, "Fotoğraf yüzleri eklenemedi", "error", err)
+		return err
+	}
+	if err := insertLabels(tx, id, photo.Labels); err != nil {
+		slog.ErrorContext(ctx, "Fotoğraf etiketleri eklenemedi", "error", err)
+		return err
+	}
+	if err := insertTags(tx, id, photo.Tags); err != nil {
+		slog.ErrorContext(ctx, "Fotoğraf etiketleri eklenemedi", "error", err)
+		return err
+	}
+	if err := insertText(tx, id, photo.Text); err != nil {
+		slog.ErrorContext(ctx, "Fotoğraf metni eklenemedi", "error", err)
+		return err
+	}
+	if err := refreshSearchVector(tx, id); err != nil {
+		slog.ErrorContext(ctx, "Arama dizini güncellenemedi", "error", err)
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		slog.ErrorContext(ctx, "Fotoğraf eklenemedi", "error", err)
+		return err
+	}
+
+	photo.Id = strconv.FormatInt(id, 10)
+	slog.InfoContext(ctx, "Fotoğraf başarıyla eklendi")
 	return nil
 }
 
-// InsertPhoto, fotoğraf bilgilerini veritabanına ekler.
-func InsertPhoto(photo *UploadedImage) error {
-	if len(photo.FaceAnalysis) > 0 {
-		_, err := db.Exec(`INSERT INTO photos (url, emotion, confidence, upload_time)
-                          VALUES ($1, $2, $3, $4)`,
-			photo.Url, photo.FaceAnalysis[0].Emotion, photo.FaceAnalysis[0].Confidence, now().UTC())
+// insertLabels, bir fotoğrafın Vision API etiketlerini verilen işlem içinde ekler.
+func insertLabels(tx *sql.Tx, photoID int64, labels []*Label) error {
+	for _, label := range labels {
+		_, err := tx.Exec(`INSERT INTO photo_labels (photo_id, description, score, mid)
+                           VALUES ($1, $2, $3, $4)
+                           ON CONFLICT (photo_id, description) DO UPDATE SET score = EXCLUDED.score, mid = EXCLUDED.mid`,
+			photoID, label.Description, label.Score, label.Mid)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
 
+// insertTags, bir fotoğrafa kullanıcı etiketlerini verilen işlem içinde ekler. Var olan etiketler atlanır.
+func insertTags(tx *sql.Tx, photoID int64, tags []string) error {
+	for _, tag := range tags {
+		_, err := tx.Exec(`INSERT INTO photo_tags (photo_id, tag, created_at)
+                           VALUES ($1, $2, $3)
+                           ON CONFLICT (photo_id, tag) DO NOTHING`,
+			photoID, tag, now().UTC())
 		if err != nil {
-			log.Printf("Fotoğraf eklenemedi: %v", err)
 			return err
 		}
-		log.Printf("Fotoğraf başarıyla eklendi")
+	}
+	return nil
+}
+
+// AddTags, bir fotoğrafa kullanıcı etiketleri ekler.
+func AddTags(ctx context.Context, photoID string, tags []string) error {
+	id, err := strconv.ParseInt(photoID, 10, 64)
+	if err != nil {
+		return err
+	}
+
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if err := insertTags(tx, id, tags); err != nil {
+		slog.ErrorContext(ctx, "Fotoğraf etiketleri eklenemedi", "error", err)
+		return err
+	}
+	if err := refreshSearchVector(tx, id); err != nil {
+		slog.ErrorContext(ctx, "Arama dizini güncellenemedi", "error", err)
+		return err
+	}
+	return tx.Commit()
+}
+
+// RemoveTags, bir fotoğraftan kullanıcı etiketlerini kaldırır.
+func RemoveTags(ctx context.Context, photoID string, tags []string) error {
+	id, err := strconv.ParseInt(photoID, 10, 64)
+	if err != nil {
+		return err
+	}
+
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	_, err = tx.ExecContext(ctx, `DELETE FROM photo_tags WHERE photo_id = $1 AND tag = ANY($2)`, id, tags)
+	if err != nil {
+		slog.ErrorContext(ctx, "Fotoğraf etiketleri silinemedi", "error", err)
+		return err
+	}
+	if err := refreshSearchVector(tx, id); err != nil {
+		slog.ErrorContext(ctx, "Arama dizini güncellenemedi", "error", err)
+		return err
+	}
+	return tx.Commit()
+}
+
+// loadImageDetails, verilen fotoğrafların yüzlerini, etiketlerini ve kullanıcı etiketlerini doldurur.
+func loadImageDetails(ctx context.Context, images []*UploadedImage) error {
+	if err := loadFaces(ctx, images); err != nil {
+		return err
+	}
+	return loadLabelsAndTags(ctx, images)
+}
+
+// loadLabelsAndTags, verilen fotoğrafların etiketlerini ve kullanıcı etiketlerini tek sorguda doldurur.
+func loadLabelsAndTags(ctx context.Context, images []*UploadedImage) error {
+	if len(images) == 0 {
 		return nil
-	} else {
-		err := errors.New("analiz bilgileri bulunamadığı için fotoğraf eklenemedi")
-		log.Printf("%v", err)
+	}
+
+	byID := make(map[string]*UploadedImage, len(images))
+	ids := make([]int64, 0, len(images))
+	for _, img := range images {
+		id, err := strconv.ParseInt(img.Id, 10, 64)
+		if err != nil {
+			return err
+		}
+		byID[img.Id] = img
+		ids = append(ids, id)
+	}
+
+	rows, err := db.QueryContext(ctx, `SELECT photo_id, description, score, COALESCE(mid, '') FROM photo_labels
+                           WHERE photo_id = ANY($1) ORDER BY photo_id, score DESC`, ids)
+	if err != nil {
+		slog.ErrorContext(ctx, "Fotoğraf etiketleri alınamadı", "error", err)
+		return err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var photoID string
+		label := &Label{}
+		if err := rows.Scan(&photoID, &label.Description, &label.Score, &label.Mid); err != nil {
+			return err
+		}
+		if img, ok := byID[photoID]; ok {
+			img.Labels = append(img.Labels, label)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	tagRows, err := db.QueryContext(ctx, `SELECT photo_id, tag FROM photo_tags
+                              WHERE photo_id = ANY($1) ORDER BY photo_id, tag`, ids)
+	if err != nil {
+		slog.ErrorContext(ctx, "Fotoğraf etiketleri alınamadı", "error", err)
+		return err
+	}
+	defer tagRows.Close()
+	for tagRows.Next() {
+		var photoID, tag string
+		if err := tagRows.Scan(&photoID, &tag); err != nil {
+			return err
+		}
+		if img, ok := byID[photoID]; ok {
+			img.Tags = append(img.Tags, tag)
+		}
+	}
+	return tagRows.Err()
+}
+
+// GetPhotosByTag, kullanıcı etiketi veya Vision API etiketi verilen değerle eşleşen fotoğrafları yeniden eskiye çeker.
+func GetPhotosByTag(ctx context.Context, tag string, limit, offset int) ([]*UploadedImage, error) {
+	rows, err := db.QueryContext(ctx, `SELECT `+photoColumns+` FROM photos p
+                           WHERE moderation_status = 'ACCEPTED'
+                             AND (EXISTS (SELECT 1 FROM photo_tags t WHERE t.photo_id = p.id AND t.tag = $1)
+                                  OR EXISTS (SELECT 1 FROM photo_labels l WHERE l.photo_id = p.id AND lower(l.description) = $1))
+                           ORDER BY upload_time DESC, id DESC
+                           LIMIT $2 OFFSET $3`, tag, limit, offset)
+	if err != nil {
+		slog.ErrorContext(ctx, "Fotoğraflar alınamadı", "error", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	var images []*UploadedImage
+	for rows.Next() {
+		img, err := scanPhoto(rows)
+		if err != nil {
+			slog.ErrorContext(ctx, "Fotoğraf alınamadı", "error", err)
+			return nil, err
+		}
+		images = append(images, img)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if err := loadImageDetails(ctx, images); err != nil {
+		return nil, err
+	}
+	return images, nil
+}
+
+// UpdatePhoto, veritabanındaki fotoğraf bilgilerini günceller. Yüzler, Vision API etiketleri ve OCR metni yenileriyle
+// değiştirilir, kullanıcı etiketleri korunur. Yüklenme zamanı değişmez; güncellenme zamanı updated_at sütununa yazılır.
+func UpdatePhoto(ctx context.Context, img *UploadedImage) error {
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	safeSearch, err := marshalSafeSearch(img.SafeSearch)
+	if err != nil {
+		return err
+	}
+
+	emotion, confidence := emotionColumns(img.FaceAnalysis)
+	lat, lng, source, name := locationColumns(img.Location)
+
+	var previousStatus string
+	err = tx.QueryRowContext(ctx, `
+		UPDATE photos p
+		SET url = $2, emotion = $3, confidence = $4, updated_at = $5, caption = NULLIF($6, ''),
+		    moderation_status = $7, moderation_reason = NULLIF($8, ''), safe_search = $9,
+		    latitude = $10, longitude = $11, location_source = $12, location_name = $13,
+		    emotion_rules_version = NULLIF($14, ''), image_width = NULLIF($15, 0), image_height = NULLIF($16, 0),
+		    captured_at = $17, face_count = $18, album_id = NULLIF($19, ''), analysis_status = $20
+		FROM photos old
+		WHERE p.id = $1 AND old.id = p.id
+		RETURNING old.moderation_status`,
+		img.Id, img.Url, emotion, confidence, now().UTC(), img.Caption,
+		moderationStatusToDB(img.ModerationStatus), img.ModerationReason, safeSearch,
+		lat, lng, source, name, img.EmotionRulesVersion, img.ImageWidth, img.ImageHeight,
+		capturedAtColumn(img.CapturedAt), len(img.FaceAnalysis), img.AlbumId,
+		analysisStatusToDB(img.AnalysisStatus)).Scan(&previousStatus)
+
+	if err != nil {
+		slog.ErrorContext(ctx, "Fotoğraf güncellenirken hata oluştu", "error", err)
 		return err
 	}
+
+	// Yeni içerik karantinaya düştüyse karar geçmişe yazılır.
+	if img.ModerationStatus == ModerationStatus_MODERATION_QUARANTINED && previousStatus != moderationQuarantined {
+		err := insertModerationDecision(tx, img.Id, moderationQuarantined, systemModerator, img.ModerationReason)
+		if err != nil {
+			slog.ErrorContext(ctx, "Denetim kararı kaydedilemedi", "error", err)
+			return err
+		}
+	}
+
+	if _, err := tx.Exec(`DELETE FROM photo_labels WHERE photo_id = $1`, img.Id); err != nil {
+		slog.ErrorContext(ctx, "Fotoğraf etiketleri silinemedi", "error", err)
+		return err
+	}
+	id, err := strconv.ParseInt(img.Id, 10, 64)
+	if err != nil {
+		return err
+	}
+	if err := insertLabels(tx, id, img.Labels); err != nil {
+		slog.ErrorContext(ctx, "Fotoğraf etiketleri eklenemedi", "error", err)
+		return err
+	}
+	if err := replaceFaces(tx, id, img.FaceAnalysis); err != nil {
+		slog.ErrorContext(ctx, "Fotoğraf yüzleri güncellenemedi", "error", err)
+		return err
+	}
+	if err := replaceText(tx, id, img.Text); err != nil {
+		slog.ErrorContext(ctx, "Fotoğraf metni güncellenemedi", "error", err)
+		return err
+	}
+	if err := refreshSearchVector(tx, id); err != nil {
+		slog.ErrorContext(ctx, "Arama dizini güncellenemedi", "error", err)
+		return err
+	}
+	// Analizi tamamlanan fotoğraf kuyruktan çıkar.
+	if img.AnalysisStatus != AnalysisStatus_ANALYSIS_PENDING {
+		if _, err := tx.Exec(`DELETE FROM analysis_queue WHERE photo_id = $1`, id); err != nil {
+			slog.ErrorContext(ctx, "Fotoğraf analiz kuyruğundan çıkarılamadı", "error", err)
+			return err
+		}
+	}
+
+	return tx.Commit()
 }
 
-// UpdatePhoto, veritabanındaki fotoğraf bilgilerini günceller.
-func UpdatePhoto(img *UploadedImage) error {
-	_, err := db.Exec(`
+// UpdatePhotoFaces, yalnızca fotoğrafın yüz analizini ve görüntü boyutlarını günceller. Etiketler, metin ve
+// denetim sonucu değişmez.
+func UpdatePhotoFaces(ctx context.Context, img *UploadedImage) error {
+	id, err := strconv.ParseInt(img.Id, 10, 64)
+	if err != nil {
+		return err
+	}
+
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	emotion, confidence := emotionColumns(img.FaceAnalysis)
+	_, err = tx.ExecContext(ctx, `
 		UPDATE photos
-		SET url = $2, emotion = $3, confidence = $4, upload_time = $5
+		SET emotion = $2, confidence = $3, emotion_rules_version = NULLIF($4, ''),
+		    image_width = NULLIF($5, 0), image_height = NULLIF($6, 0), face_count = $7, updated_at = $8
 		WHERE id = $1`,
-		img.Id, img.Url, img.FaceAnalysis[0].Emotion, img.FaceAnalysis[0].Confidence, time.Unix(img.UploadTime, 0))
-
+		id, emotion, confidence, img.EmotionRulesVersion,
+		img.ImageWidth, img.ImageHeight, len(img.FaceAnalysis), now().UTC())
 	if err != nil {
-		log.Printf("Fotoğraf güncellenirken hata oluştu: %v", err)
+		slog.ErrorContext(ctx, "Fotoğraf yüzleri güncellenemedi", "error", err)
+		return err
+	}
+	if err := replaceFaces(tx, id, img.FaceAnalysis); err != nil {
+		slog.ErrorContext(ctx, "Fotoğraf yüzleri güncellenemedi", "error", err)
+		return err
+	}
+	if err := refreshSearchVector(tx, id); err != nil {
+		slog.ErrorContext(ctx, "Arama dizini güncellenemedi", "error", err)
 		return err
 	}
 
-	return nil
+	return tx.Commit()
 }
@@ -0,0 +1,240 @@
+package photo
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"log/slog"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"myphotoapp/config"
+
+	"gopkg.in/yaml.v2"
+)
+
+// builtinRuleSetVersion, kural dosyası yapılandırılmadığında kaydedilen kural seti sürümüdür.
+const builtinRuleSetVersion = "builtin"
+
+// defaultRulesReloadInterval, kural dosyasının değişiklik için yoklanma aralığıdır.
+const defaultRulesReloadInterval = 30 * time.Second
+
+// emotionRuleSignals, kural koşullarında kullanılabilecek yüz sinyalleridir.
+var emotionRuleSignals = map[string]func(FaceScores) Likelihood{
+	"joy":           func(f FaceScores) Likelihood { return f.Joy },
+	"sorrow":        func(f FaceScores) Likelihood { return f.Sorrow },
+	"anger":         func(f FaceScores) Likelihood { return f.Anger },
+	"surprise":      func(f FaceScores) Likelihood { return f.Surprise },
+	"blurred":       func(f FaceScores) Likelihood { return f.Blurred },
+	"headwear":      func(f FaceScores) Likelihood { return f.Headwear },
+	"under_exposed": func(f FaceScores) Likelihood { return f.UnderExposed },
+}
+
+// emotionRuleFile, kural dosyasının YAML biçimidir.
+//
+//	version: "2024-03-01"
+//	rules:
+//	  - label: Excited
+//	    when:
+//	      joy: LIKELY
+//	      surprise: POSSIBLE
+type emotionRuleFile struct {
+	Version string `yaml:"version"`
+	Rules   []struct {
+		Label string            `yaml:"label"`
+		When  map[string]string `yaml:"when"`
+	} `yaml:"rules"`
+}
+
+// emotionCondition, bir sinyalin ulaşması gereken en düşük olasılık derecesidir.
+type emotionCondition struct {
+	signal    string
+	threshold Likelihood
+}
+
+// emotionRule, tüm koşulları sağlandığında yüze verilen etikettir.
+type emotionRule struct {
+	label      string
+	conditions []emotionCondition
+}
+
+// EmotionRuleSet, doğrulanmış ve sıralı duygu kurallarıdır.
+type EmotionRuleSet struct {
+	Version string
+	rules   []emotionRule
+}
+
+// ParseEmotionRules, YAML kural dosyasını doğrular ve bir EmotionRuleSet oluşturur. Kural seti sürümü,
+// dosyadaki sürüm ve içeriğin özetinden oluşur (örneğin, "3-1a2b3c4d5e6f"); dosyada sürüm yoksa yalnızca özettir.
+func ParseEmotionRules(data []byte) (*EmotionRuleSet, error) {
+	var file emotionRuleFile
+	if err := yaml.UnmarshalStrict(data, &file); err != nil {
+		return nil, fmt.Errorf("kural dosyası çözümlenemedi: %v", err)
+	}
+
+	// Sürüme her zaman içeriğin özeti eklenir; dosya sürüm artırılmadan değiştirilse de kayıtlardaki sürüm
+	// hangi kurallarla sınıflandırıldıklarını gösterir.
+	sum := sha256.Sum256(data)
+	set := &EmotionRuleSet{Version: hex.EncodeToString(sum[:6])}
+	if version := strings.TrimSpace(file.Version); version != "" {
+		set.Version = version + "-" + set.Version
+	}
+
+	for i, raw := range file.Rules {
+		label := strings.TrimSpace(raw.Label)
+		if label == "" {
+			return nil, fmt.Errorf("%d. kuralın etiketi boş", i+1)
+		}
+		if len(raw.When) == 0 {
+			return nil, fmt.Errorf("%q kuralının koşulu yok", label)
+		}
+
+		rule := emotionRule{label: label}
+		for signal, name := range raw.When {
+			signal = strings.ToLower(signal)
+			if _, ok := emotionRuleSignals[signal]; !ok {
+				return nil, fmt.Errorf("%q kuralında bilinmeyen sinyal: %q", label, signal)
+			}
+			value, ok := Likelihood_value["LIKELIHOOD_"+strings.ToUpper(name)]
+			if !ok || Likelihood(value) == Likelihood_LIKELIHOOD_UNKNOWN {
+				return nil, fmt.Errorf("%q kuralında %s için geçersiz olasılık: %q", label, signal, name)
+			}
+			rule.conditions = append(rule.conditions, emotionCondition{signal: signal, threshold: Likelihood(value)})
+		}
+		set.rules = append(set.rules, rule)
+	}
+	return set, nil
+}
+
+// Classify, kuralları sırayla dener ve koşulları sağlanan ilk kuralın etiketini döndürür. Güvenilirlik, kuraldaki
+// sinyallerin en düşük olasılığıdır; yani VE bağlacının en zayıf halkası kadar güçlüdür.
+// Hiçbir kural eşleşmezse puanlardan türetilen baskın duygu kullanılır.
+func (r *EmotionRuleSet) Classify(scores FaceScores) (string, float64) {
+	for _, rule := range r.rules {
+		confidence, ok := rule.match(scores)
+		if ok {
+			return rule.label, confidence
+		}
+	}
+	return scores.dominantEmotion()
+}
+
+func (r emotionRule) match(scores FaceScores) (float64, bool) {
+	confidence := 1.0
+	for _, c := range r.conditions {
+		value := emotionRuleSignals[c.signal](scores)
+		if value < c.threshold {
+			return 0, false
+		}
+		if p := likelihoodProbability(value); p < confidence {
+			confidence = p
+		}
+	}
+	return confidence, true
+}
+
+// EmotionClassifier, yürürlükteki kural setini tutar ve kural dosyası değiştiğinde yeniden yükler.
+type EmotionClassifier struct {
+	path     string
+	interval time.Duration
+
+	mu      sync.RWMutex
+	rules   *EmotionRuleSet
+	modTime time.Time
+}
+
+// NewEmotionClassifier, yapılandırmadaki kural dosyasını yükler. Dosya geçersizse hata döndürür; böylece hatalı
+// kurallarla başlatma yapılmaz. Dosya yapılandırılmamışsa yalnızca baskın duygu kullanılır.
+func NewEmotionClassifier(cfg config.EmotionConfig) (*EmotionClassifier, error) {
+	c := &EmotionClassifier{
+		path:     cfg.RulesFile,
+		interval: cfg.ReloadInterval,
+		rules:    &EmotionRuleSet{Version: builtinRuleSetVersion},
+	}
+	if c.interval <= 0 {
+		c.interval = defaultRulesReloadInterval
+	}
+	if c.path == "" {
+		return c, nil
+	}
+
+	if _, err := c.reload(); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// reload, kural dosyası son yüklemeden sonra değiştiyse yeniden okur. Yeni kurallar geçersizse eskiler korunur.
+func (c *EmotionClassifier) reload() (bool, error) {
+	info, err := os.Stat(c.path)
+	if err != nil {
+		return false, fmt.Errorf("kural dosyası okunamadı: %v", err)
+	}
+
+	c.mu.RLock()
+	unchanged := info.ModTime().Equal(c.modTime)
+	c.mu.RUnlock()
+	if unchanged {
+		return false, nil
+	}
+
+	data, err := os.ReadFile(c.path)
+	if err != nil {
+		return false, fmt.Errorf("kural dosyası okunamadı: %v", err)
+	}
+	rules, err := ParseEmotionRules(data)
+	if err != nil {
+		return false, err
+	}
+
+	c.mu.Lock()
+	c.rules = rules
+	c.modTime = info.ModTime()
+	c.mu.Unlock()
+	return true, nil
+}
+
+// Watch, bağlam iptal edilene kadar kural dosyasını düzenli aralıklarla yoklar ve değişiklikleri uygular.
+func (c *EmotionClassifier) Watch(ctx context.Context) {
+	if c.path == "" {
+		return
+	}
+
+	ticker := time.NewTicker(c.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			changed, err := c.reload()
+			if err != nil {
+				slog.WarnContext(ctx, "Duygu kuralları yeniden yüklenemedi, önceki kurallar kullanılıyor", "error", err)
+				continue
+			}
+			if changed {
+				slog.InfoContext(ctx, "Duygu kuralları yeniden yüklendi", "version", c.Rules().Version)
+			}
+		}
+	}
+}
+
+// Rules, yürürlükteki kural setini döndürür.
+func (c *EmotionClassifier) Rules() *EmotionRuleSet {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.rules
+}
+
+// ClassifyFaces, yüzlerin duygu etiketlerini yürürlükteki kurallarla belirler ve kullanılan kural seti sürümünü
+// döndürür. Aynı analizdeki tüm yüzler aynı kural setiyle sınıflandırılır.
+func (c *EmotionClassifier) ClassifyFaces(faces []*FaceAnalysisResult) string {
+	rules := c.Rules()
+	for _, face := range faces {
+		face.Emotion, face.Confidence = rules.Classify(face.Scores)
+	}
+	return rules.Version
+}
@@ -0,0 +1,142 @@
+package photo
+
+import (
+	"os"
+	"strings"
+	"testing"
+)
+
+const testEmotionRules = `
+version: "test"
+rules:
+  - label: Excited
+    when:
+      joy: LIKELY
+      surprise: POSSIBLE
+  - label: Shocked
+    when:
+      anger: possible
+      SURPRISE: VERY_LIKELY
+`
+
+func TestParseEmotionRulesRejects(t *testing.T) {
+	tests := []struct {
+		name    string
+		yaml    string
+		wantErr string
+	}{
+		{"geçersiz YAML", "rules: [", "çözümlenemedi"},
+		{"bilinmeyen alan", "rules:\n  - label: A\n    when: {joy: LIKELY}\n    weight: 2\n", "çözümlenemedi"},
+		{"boş etiket", "rules:\n  - label: \" \"\n    when: {joy: LIKELY}\n", "etiketi boş"},
+		{"koşulsuz kural", "rules:\n  - label: A\n", "koşulu yok"},
+		{"bilinmeyen sinyal", "rules:\n  - label: A\n    when: {happiness: LIKELY}\n", "bilinmeyen sinyal"},
+		{"bilinmeyen olasılık", "rules:\n  - label: A\n    when: {joy: SOMEWHAT}\n", "geçersiz olasılık"},
+		{"UNKNOWN eşiği", "rules:\n  - label: A\n    when: {joy: UNKNOWN}\n", "geçersiz olasılık"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseEmotionRules([]byte(tt.yaml))
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("ParseEmotionRules = %v, want %q içeren hata", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestEmotionRuleSetClassify(t *testing.T) {
+	rules, err := ParseEmotionRules([]byte(testEmotionRules))
+	if err != nil {
+		t.Fatalf("ParseEmotionRules: %v", err)
+	}
+
+	tests := []struct {
+		name           string
+		scores         FaceScores
+		wantLabel      string
+		wantConfidence Likelihood // Güvenilirliği veren derece
+	}{
+		{
+			name:           "tüm koşullar sağlanır",
+			scores:         FaceScores{Joy: Likelihood_LIKELIHOOD_VERY_LIKELY, Surprise: Likelihood_LIKELIHOOD_POSSIBLE},
+			wantLabel:      "Excited",
+			wantConfidence: Likelihood_LIKELIHOOD_POSSIBLE,
+		},
+		{
+			name:           "bir koşul eksik, baskın duyguya düşer",
+			scores:         FaceScores{Joy: Likelihood_LIKELIHOOD_VERY_LIKELY, Surprise: Likelihood_LIKELIHOOD_UNLIKELY},
+			wantLabel:      "Joy",
+			wantConfidence: Likelihood_LIKELIHOOD_VERY_LIKELY,
+		},
+		{
+			name:           "ilk eşleşen kural kazanır",
+			scores:         FaceScores{Joy: Likelihood_LIKELIHOOD_LIKELY, Anger: Likelihood_LIKELIHOOD_LIKELY, Surprise: Likelihood_LIKELIHOOD_VERY_LIKELY},
+			wantLabel:      "Excited",
+			wantConfidence: Likelihood_LIKELIHOOD_LIKELY,
+		},
+		{
+			name:           "sinyal ve olasılık adları büyük/küçük harf duyarsız",
+			scores:         FaceScores{Anger: Likelihood_LIKELIHOOD_POSSIBLE, Surprise: Likelihood_LIKELIHOOD_VERY_LIKELY},
+			wantLabel:      "Shocked",
+			wantConfidence: Likelihood_LIKELIHOOD_POSSIBLE,
+		},
+		{
+			name:           "hiçbir kural ve duygu eşleşmez",
+			scores:         FaceScores{Joy: Likelihood_LIKELIHOOD_POSSIBLE},
+			wantLabel:      unknownEmotion,
+			wantConfidence: Likelihood_LIKELIHOOD_UNKNOWN,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			label, confidence := rules.Classify(tt.scores)
+			want := likelihoodProbability(tt.wantConfidence)
+			if tt.wantConfidence == Likelihood_LIKELIHOOD_UNKNOWN {
+				want = 0
+			}
+			if label != tt.wantLabel || confidence != want {
+				t.Errorf("Classify = (%s, %v), want (%s, %v)", label, confidence, tt.wantLabel, want)
+			}
+		})
+	}
+}
+
+func TestParseEmotionRulesRepositoryFile(t *testing.T) {
+	data, err := os.ReadFile("../../config/emotion_rules.yaml")
+	if err != nil {
+		t.Skipf("kural dosyası okunamadı: %v", err)
+	}
+	if _, err := ParseEmotionRules(data); err != nil {
+		t.Errorf("config/emotion_rules.yaml: %v", err)
+	}
+}
+
+func TestParseEmotionRulesVersion(t *testing.T) {
+	const rules = "rules:\n  - label: A\n    when: {joy: LIKELY}\n"
+	const changed = "rules:\n  - label: A\n    when: {joy: POSSIBLE}\n"
+
+	parse := func(data string) string {
+		t.Helper()
+		set, err := ParseEmotionRules([]byte(data))
+		if err != nil {
+			t.Fatalf("ParseEmotionRules: %v", err)
+		}
+		return set.Version
+	}
+
+	unversioned := parse(rules)
+	if len(unversioned) != 12 {
+		t.Errorf("sürümsüz dosyanın sürümü = %q, want 12 haneli özet", unversioned)
+	}
+	if v := parse(rules); v != unversioned {
+		t.Errorf("aynı içeriğin sürümü = %q, want %q", v, unversioned)
+	}
+
+	versioned := parse("version: \"3\"\n" + rules)
+	if !strings.HasPrefix(versioned, "3-") || len(versioned) != len("3-")+12 {
+		t.Errorf("sürümlü dosyanın sürümü = %q, want \"3-<özet>\"", versioned)
+	}
+	// Sürüm artırılmadan değiştirilen kurallar farklı bir sürümle kaydedilmelidir.
+	if v := parse("version: \"3\"\n" + changed); v == versioned {
+		t.Errorf("değişen kuralların sürümü değişmedi: %q", v)
+	}
+}
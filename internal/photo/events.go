@@ -0,0 +1,165 @@
+package photo
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"log/slog"
+	"mime"
+	"strconv"
+
+	"github.com/confluentinc/confluent-kafka-go/kafka"
+	"go.opentelemetry.io/otel/propagation"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// eventsTopic, fotoğraf olaylarının yayımlandığı Kafka konusudur.
+const eventsTopic = "image-upload-topic"
+
+// eventSchemaVersion, bu sürümün ürettiği ve çözebildiği en yüksek olay şeması sürümüdür.
+const eventSchemaVersion = 1
+
+// Olay mesajlarının başlıkları. Tüketiciler mesajı çözmeden içeriğin biçimini ve olay türünü bunlardan okur.
+const (
+	contentTypeHeader   = "content-type"
+	eventTypeHeader     = "event-type"
+	schemaVersionHeader = "schema-version"
+
+	eventMediaType   = "application/x-protobuf"
+	eventMessageType = "photo.EventEnvelope"
+)
+
+var eventContentType = mime.FormatMediaType(eventMediaType, map[string]string{"messageType": eventMessageType})
+
+// newEvent, verilen içerik için zarfı oluşturur. Bağlamdaki iz ve istek kimliği zarfa yazılır.
+func newEvent(ctx context.Context, payload isEventEnvelope_Payload) *EventEnvelope {
+	event := &EventEnvelope{
+		EventId:       newEventID(),
+		SchemaVersion: eventSchemaVersion,
+		OccurredAt:    timestamppb.New(now()),
+		Producer:      defaultServiceName,
+		RequestId:     RequestIDFromContext(ctx),
+		Payload:       payload,
+	}
+
+	switch payload.(type) {
+	case *EventEnvelope_ImageUploaded:
+		event.Type = EventType_EVENT_TYPE_IMAGE_UPLOADED
+	case *EventEnvelope_ImageUpdated:
+		event.Type = EventType_EVENT_TYPE_IMAGE_UPDATED
+	case *EventEnvelope_ImageDeleted:
+		event.Type = EventType_EVENT_TYPE_IMAGE_DELETED
+	case *EventEnvelope_AnalysisCompleted:
+		event.Type = EventType_EVENT_TYPE_ANALYSIS_COMPLETED
+	case *EventEnvelope_AnalysisFailed:
+		event.Type = EventType_EVENT_TYPE_ANALYSIS_FAILED
+	}
+
+	carrier := propagation.MapCarrier{}
+	propagation.TraceContext{}.Inject(ctx, carrier)
+	if traceparent := carrier.Get("traceparent"); traceparent != "" {
+		event.TraceContext = &TraceContext{Traceparent: traceparent, Tracestate: carrier.Get("tracestate")}
+	}
+	return event
+}
+
+// newEventID, rastgele bir UUID (sürüm 4) üretir.
+func newEventID() string {
+	var b [16]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		return ""
+	}
+	b[6] = b[6]&0x0f | 0x40
+	b[8] = b[8]&0x3f | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:])
+}
+
+// eventPhotoID, olayın ilgili olduğu fotoğrafın ID'sini döndürür. Mesaj anahtarı olarak kullanılır.
+func eventPhotoID(event *EventEnvelope) string {
+	switch p := event.Payload.(type) {
+	case *EventEnvelope_ImageUploaded:
+		return p.ImageUploaded.GetPhotoId()
+	case *EventEnvelope_ImageUpdated:
+		return p.ImageUpdated.GetPhotoId()
+	case *EventEnvelope_ImageDeleted:
+		return p.ImageDeleted.GetPhotoId()
+	case *EventEnvelope_AnalysisCompleted:
+		return p.AnalysisCompleted.GetPhotoId()
+	case *EventEnvelope_AnalysisFailed:
+		return p.AnalysisFailed.GetPhotoId()
+	}
+	return ""
+}
+
+// PublishEvent, olayı fotoğraf ID'siyle anahtarlayarak yayımlar. Aynı fotoğrafın olayları aynı bölüme
+// düştüğünden tüketiciler bunları üretildikleri sırayla alır.
+func (kp *KafkaProducer) PublishEvent(ctx context.Context, event *EventEnvelope) error {
+	value, err := proto.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("olay kodlanamadı: %v", err)
+	}
+	headers := []kafka.Header{
+		{Key: contentTypeHeader, Value: []byte(eventContentType)},
+		{Key: eventTypeHeader, Value: []byte(event.Type.String())},
+		{Key: schemaVersionHeader, Value: []byte(strconv.Itoa(int(event.SchemaVersion)))},
+	}
+	return kp.ProduceMessage(ctx, eventsTopic, []byte(eventPhotoID(event)), value, headers)
+}
+
+// DecodeEvent, tüketilen Kafka mesajındaki olayı çözer. İçerik türü başlığı olmayan ya da farklı olan
+// mesajlar ve bu sürümün bilmediği, daha yeni bir şemayla üretilmiş olaylar hata döndürür.
+func DecodeEvent(msg *kafka.Message) (*EventEnvelope, error) {
+	contentType := ""
+	for _, h := range msg.Headers {
+		if h.Key == contentTypeHeader {
+			contentType = string(h.Value)
+			break
+		}
+	}
+	if contentType == "" {
+		return nil, errors.New("mesajda içerik türü başlığı yok")
+	}
+	mediaType, params, err := mime.ParseMediaType(contentType)
+	if err != nil || mediaType != eventMediaType {
+		return nil, fmt.Errorf("desteklenmeyen içerik türü: %q", contentType)
+	}
+	if messageType, ok := params["messagetype"]; ok && messageType != eventMessageType {
+		return nil, fmt.Errorf("desteklenmeyen mesaj türü: %q", messageType)
+	}
+
+	event := &EventEnvelope{}
+	if err := proto.Unmarshal(msg.Value, event); err != nil {
+		return nil, fmt.Errorf("olay çözümlenemedi: %v", err)
+	}
+	if event.SchemaVersion > eventSchemaVersion {
+		return nil, fmt.Errorf("desteklenmeyen olay şeması sürümü: %d", event.SchemaVersion)
+	}
+	if event.Payload == nil {
+		return nil, fmt.Errorf("olayın içeriği yok (tür %s)", event.Type)
+	}
+	return event, nil
+}
+
+// imageUploadedEvent, kaydedilen fotoğraf için yükleme olayının içeriğini oluşturur.
+func imageUploadedEvent(img *UploadedImage) *EventEnvelope_ImageUploaded {
+	return &EventEnvelope_ImageUploaded{ImageUploaded: &ImageUploaded{
+		PhotoId:          img.Id,
+		Url:              img.Url,
+		OwnerId:          img.OwnerId,
+		Tags:             img.Tags,
+		AnalysisStatus:   img.AnalysisStatus,
+		ModerationStatus: img.ModerationStatus,
+	}}
+}
+
+// publishEvent, olayı yayımlar. Fotoğraf zaten kaydedildiğinden yayımlama hatası isteği başarısız yapmaz,
+// yalnızca günlüğe yazılır.
+func (s *PhotoService) publishEvent(ctx context.Context, payload isEventEnvelope_Payload) {
+	event := newEvent(ctx, payload)
+	if err := s.kafkaProducer.PublishEvent(ctx, event); err != nil {
+		slog.ErrorContext(ctx, "Kafka'ya olay gönderilemedi", "event_type", event.Type.String(),
+			"event_id", event.EventId, "error", err)
+	}
+}
@@ -0,0 +1,75 @@
+package photo
+
+import (
+	"context"
+	"testing"
+
+	"github.com/confluentinc/confluent-kafka-go/kafka"
+	"google.golang.org/protobuf/proto"
+)
+
+// eventMessage, olayı PublishEvent'in ürettiği başlıklarla bir Kafka mesajına çevirir.
+func eventMessage(t *testing.T, event *EventEnvelope, contentType string) *kafka.Message {
+	t.Helper()
+	value, err := proto.Marshal(event)
+	if err != nil {
+		t.Fatal(err)
+	}
+	msg := &kafka.Message{Key: []byte(eventPhotoID(event)), Value: value}
+	if contentType != "" {
+		msg.Headers = append(msg.Headers, kafka.Header{Key: contentTypeHeader, Value: []byte(contentType)})
+	}
+	return msg
+}
+
+func TestNewEvent(t *testing.T) {
+	recordSpans(t)
+	ctx, span := tracer.Start(WithRequestID(context.Background(), "istek-1"), "istek")
+	defer span.End()
+
+	event := newEvent(ctx, imageUploadedEvent(&UploadedImage{Id: "42", Url: "https://example.com/a.jpg"}))
+	if event.Type != EventType_EVENT_TYPE_IMAGE_UPLOADED || event.SchemaVersion != eventSchemaVersion {
+		t.Errorf("olay türü ve sürümü = %s, %d", event.Type, event.SchemaVersion)
+	}
+	if event.EventId == "" || event.RequestId != "istek-1" {
+		t.Errorf("olay kimliği = %q, istek kimliği = %q", event.EventId, event.RequestId)
+	}
+	if event.GetTraceContext().GetTraceparent() == "" {
+		t.Error("olayda iz bağlamı yok")
+	}
+	if got := eventPhotoID(event); got != "42" {
+		t.Errorf("mesaj anahtarı = %q, want 42", got)
+	}
+}
+
+func TestDecodeEvent(t *testing.T) {
+	event := newEvent(context.Background(), &EventEnvelope_AnalysisFailed{AnalysisFailed: &AnalysisFailed{
+		PhotoId: "7", Reason: "Vision API'ye ulaşılamadı", Attempts: 5,
+	}})
+
+	got, err := DecodeEvent(eventMessage(t, event, eventContentType))
+	if err != nil {
+		t.Fatalf("DecodeEvent = %v", err)
+	}
+	if !proto.Equal(got, event) {
+		t.Errorf("çözülen olay = %v, want %v", got, event)
+	}
+
+	newer := proto.Clone(event).(*EventEnvelope)
+	newer.SchemaVersion = eventSchemaVersion + 1
+	tests := []struct {
+		name string
+		msg  *kafka.Message
+	}{
+		{"içerik türü yok", eventMessage(t, event, "")},
+		{"farklı içerik türü", eventMessage(t, event, "application/json")},
+		{"farklı mesaj türü", eventMessage(t, event, eventMediaType+"; messageType=photo.Other")},
+		{"daha yeni şema", eventMessage(t, newer, eventContentType)},
+		{"içeriksiz olay", eventMessage(t, &EventEnvelope{SchemaVersion: eventSchemaVersion}, eventContentType)},
+	}
+	for _, tt := range tests {
+		if _, err := DecodeEvent(tt.msg); err == nil {
+			t.Errorf("%s: DecodeEvent hata vermedi", tt.name)
+		}
+	}
+}
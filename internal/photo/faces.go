@@ -0,0 +1,241 @@
+package photo
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"log/slog"
+	"strconv"
+)
+
+// likelihoodProbabilities, Vision API olasılık derecelerini olasılık değerlerine çevirir.
+// Vision API sayısal bir puan vermez; değerler her derecenin temsil ettiği aralığın yaklaşık ortasıdır ve
+// proto'daki LikelihoodScore açıklamasıyla aynı tutulmalıdır. UNKNOWN, sinyal olmadığı için 0 sayılır.
+var likelihoodProbabilities = map[Likelihood]float64{
+	Likelihood_LIKELIHOOD_UNKNOWN:       0,
+	Likelihood_LIKELIHOOD_VERY_UNLIKELY: 0.05,
+	Likelihood_LIKELIHOOD_UNLIKELY:      0.25,
+	Likelihood_LIKELIHOOD_POSSIBLE:      0.5,
+	Likelihood_LIKELIHOOD_LIKELY:        0.75,
+	Likelihood_LIKELIHOOD_VERY_LIKELY:   0.95,
+}
+
+// minDominantLikelihood, bir duygunun baskın sayılması için gereken en düşük olasılık derecesidir.
+// Altında kalan yüzler "Unknown" olarak işaretlenir.
+const minDominantLikelihood = Likelihood_LIKELIHOOD_LIKELY
+
+// unknownEmotion, hiçbir duygu baskın değilse kullanılan etikettir.
+const unknownEmotion = "Unknown"
+
+// likelihoodProbability, bir olasılık derecesinin olasılık değerini döndürür.
+func likelihoodProbability(l Likelihood) float64 {
+	return likelihoodProbabilities[l]
+}
+
+// newLikelihoodScore, olasılık derecesini proto'daki LikelihoodScore mesajına çevirir.
+func newLikelihoodScore(l Likelihood) *LikelihoodScore {
+	return &LikelihoodScore{Likelihood: l, Probability: float32(likelihoodProbability(l))}
+}
+
+// FaceScores, bir yüz için Vision API'nin döndürdüğü tüm duygu ve özellik dereceleridir.
+type FaceScores struct {
+	Joy          Likelihood
+	Sorrow       Likelihood
+	Anger        Likelihood
+	Surprise     Likelihood
+	Blurred      Likelihood
+	Headwear     Likelihood
+	UnderExposed Likelihood
+}
+
+// emotions, duyguları eşitlik durumunda öncelik sırasıyla döndürür.
+func (f FaceScores) emotions() []struct {
+	name       string
+	likelihood Likelihood
+} {
+	return []struct {
+		name       string
+		likelihood Likelihood
+	}{
+		{"Joy", f.Joy},
+		{"Sorrow", f.Sorrow},
+		{"Anger", f.Anger},
+		{"Surprise", f.Surprise},
+	}
+}
+
+// dominantEmotion, en yüksek olasılıklı duyguyu ve olasılığını döndürür. Eşitlikte Joy, Sorrow, Anger,
+// Surprise sırası geçerlidir. Hiçbir duygu minDominantLikelihood derecesine ulaşmazsa "Unknown" ve 0 döner.
+func (f FaceScores) dominantEmotion() (string, float64) {
+	best, bestLikelihood := unknownEmotion, Likelihood_LIKELIHOOD_UNKNOWN
+	for _, e := range f.emotions() {
+		if e.likelihood > bestLikelihood {
+			best, bestLikelihood = e.name, e.likelihood
+		}
+	}
+	if bestLikelihood < minDominantLikelihood {
+		return unknownEmotion, 0
+	}
+	return best, likelihoodProbability(bestLikelihood)
+}
+
+// toProtoFace, bir yüz analizi sonucunu proto mesajına çevirir.
+func toProtoFace(face *FaceAnalysisResult) *FaceAnalysis {
+	return &FaceAnalysis{
+		Emotion:             face.Emotion,
+		Confidence:          float32(face.Confidence),
+		Emotions:            face.Scores.toProtoEmotions(),
+		Attributes:          face.Scores.toProtoAttributes(),
+		DetectionConfidence: float32(face.DetectionConfidence),
+		Geometry:            face.Geometry,
+	}
+}
+
+// toProtoFaces, yüz analizi sonuçlarını Vision API'nin döndürdüğü sırayla proto mesajlarına çevirir.
+func toProtoFaces(faces []*FaceAnalysisResult) []*FaceAnalysis {
+	result := make([]*FaceAnalysis, 0, len(faces))
+	for _, face := range faces {
+		result = append(result, toProtoFace(face))
+	}
+	return result
+}
+
+func (f FaceScores) toProtoEmotions() *EmotionScores {
+	return &EmotionScores{
+		Joy:      newLikelihoodScore(f.Joy),
+		Sorrow:   newLikelihoodScore(f.Sorrow),
+		Anger:    newLikelihoodScore(f.Anger),
+		Surprise: newLikelihoodScore(f.Surprise),
+	}
+}
+
+func (f FaceScores) toProtoAttributes() *FaceAttributes {
+	return &FaceAttributes{
+		Blurred:      newLikelihoodScore(f.Blurred),
+		Headwear:     newLikelihoodScore(f.Headwear),
+		UnderExposed: newLikelihoodScore(f.UnderExposed),
+	}
+}
+
+// faceScoresFromProto, proto mesajındaki dereceleri veritabanına yazmak için geri çevirir.
+func faceScoresFromProto(face *FaceAnalysis) FaceScores {
+	return FaceScores{
+		Joy:          face.GetEmotions().GetJoy().GetLikelihood(),
+		Sorrow:       face.GetEmotions().GetSorrow().GetLikelihood(),
+		Anger:        face.GetEmotions().GetAnger().GetLikelihood(),
+		Surprise:     face.GetEmotions().GetSurprise().GetLikelihood(),
+		Blurred:      face.GetAttributes().GetBlurred().GetLikelihood(),
+		Headwear:     face.GetAttributes().GetHeadwear().GetLikelihood(),
+		UnderExposed: face.GetAttributes().GetUnderExposed().GetLikelihood(),
+	}
+}
+
+// marshalGeometry, yüz geometrisini JSONB sütununa yazılacak biçime çevirir. Geometri yoksa NULL yazılır.
+func marshalGeometry(geometry *FaceGeometry) (any, error) {
+	if geometry == nil {
+		return nil, nil
+	}
+	data, err := json.Marshal(geometry)
+	if err != nil {
+		return nil, err
+	}
+	return string(data), nil
+}
+
+// insertFaces, bir fotoğrafın tüm yüzlerini verilen işlem içinde ekler. Olasılık dereceleri sayısal değerleriyle
+// saklanır; olasılıklar okunurken eşlemeden hesaplanır.
+func insertFaces(tx *sql.Tx, photoID int64, faces []*FaceAnalysis) error {
+	for i, face := range faces {
+		scores := faceScoresFromProto(face)
+		geometry, err := marshalGeometry(face.Geometry)
+		if err != nil {
+			return err
+		}
+		_, err = tx.Exec(`INSERT INTO photo_faces (photo_id, face_index, emotion, confidence, detection_confidence,
+                                                   joy, sorrow, anger, surprise, blurred, headwear, under_exposed, geometry)
+                           VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)`,
+			photoID, i, face.Emotion, face.Confidence, face.DetectionConfidence,
+			int32(scores.Joy), int32(scores.Sorrow), int32(scores.Anger), int32(scores.Surprise),
+			int32(scores.Blurred), int32(scores.Headwear), int32(scores.UnderExposed), geometry)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// replaceFaces, bir fotoğrafın yüzlerini yeni analiz sonuçlarıyla değiştirir. Yüz sıraları yeni görüntüye ait
+// olduğundan eski kişi atamaları da kaldırılır.
+func replaceFaces(tx *sql.Tx, photoID int64, faces []*FaceAnalysis) error {
+	if _, err := tx.Exec(`DELETE FROM photo_faces WHERE photo_id = $1`, photoID); err != nil {
+		return err
+	}
+	return insertFaces(tx, photoID, faces)
+}
+
+// loadFaces, verilen fotoğrafların kayıtlı yüzlerini tek sorguda doldurur. Yüz kaydı olmayan eski fotoğraflar
+// photos tablosundaki tek duygu bilgisiyle kalır.
+func loadFaces(ctx context.Context, images []*UploadedImage) error {
+	if len(images) == 0 {
+		return nil
+	}
+
+	byID := make(map[string]*UploadedImage, len(images))
+	ids := make([]int64, 0, len(images))
+	for _, img := range images {
+		id, err := strconv.ParseInt(img.Id, 10, 64)
+		if err != nil {
+			return err
+		}
+		byID[img.Id] = img
+		ids = append(ids, id)
+	}
+
+	rows, err := db.QueryContext(ctx, `SELECT f.photo_id, f.emotion, f.confidence, f.detection_confidence,
+                                  f.joy, f.sorrow, f.anger, f.surprise, f.blurred, f.headwear, f.under_exposed, f.geometry,
+                                  COALESCE(f.person_id::text, ''), COALESCE(pr.name, '')
+                           FROM photo_faces f
+                           LEFT JOIN persons pr ON pr.id = f.person_id
+                           WHERE f.photo_id = ANY($1) ORDER BY f.photo_id, f.face_index`, ids)
+	if err != nil {
+		slog.ErrorContext(ctx, "Fotoğraf yüzleri alınamadı", "error", err)
+		return err
+	}
+	defer rows.Close()
+
+	loaded := make(map[string][]*FaceAnalysis, len(images))
+	for rows.Next() {
+		var photoID string
+		var confidence, detectionConfidence float64
+		var scores FaceScores
+		var geometry []byte
+		face := &FaceAnalysis{}
+		err := rows.Scan(&photoID, &face.Emotion, &confidence, &detectionConfidence,
+			&scores.Joy, &scores.Sorrow, &scores.Anger, &scores.Surprise,
+			&scores.Blurred, &scores.Headwear, &scores.UnderExposed, &geometry, &face.PersonId, &face.PersonName)
+		if err != nil {
+			return err
+		}
+		if len(geometry) > 0 {
+			face.Geometry = &FaceGeometry{}
+			if err := json.Unmarshal(geometry, face.Geometry); err != nil {
+				return err
+			}
+		}
+		face.Confidence = float32(confidence)
+		face.DetectionConfidence = float32(detectionConfidence)
+		face.Emotions = scores.toProtoEmotions()
+		face.Attributes = scores.toProtoAttributes()
+		loaded[photoID] = append(loaded[photoID], face)
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	for photoID, faces := range loaded {
+		if img, ok := byID[photoID]; ok {
+			img.FaceAnalysis = faces
+		}
+	}
+	return nil
+}
@@ -0,0 +1,52 @@
+package photo
+
+import (
+	"testing"
+)
+
+func TestDominantEmotion(t *testing.T) {
+	tests := []struct {
+		name           string
+		scores         FaceScores
+		wantEmotion    string
+		wantConfidence float64
+	}{
+		{"tek baskın duygu", FaceScores{Joy: Likelihood_LIKELIHOOD_VERY_LIKELY, Sorrow: Likelihood_LIKELIHOOD_UNLIKELY}, "Joy", 0.95},
+		{"en yüksek derece kazanır", FaceScores{Joy: Likelihood_LIKELIHOOD_LIKELY, Anger: Likelihood_LIKELIHOOD_VERY_LIKELY}, "Anger", 0.95},
+		{"eşitlikte öncelik sırası", FaceScores{Sorrow: Likelihood_LIKELIHOOD_LIKELY, Surprise: Likelihood_LIKELIHOOD_LIKELY}, "Sorrow", 0.75},
+		{"eşik altı", FaceScores{Joy: Likelihood_LIKELIHOOD_POSSIBLE}, unknownEmotion, 0},
+		{"derece yok", FaceScores{}, unknownEmotion, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			emotion, confidence := tt.scores.dominantEmotion()
+			if emotion != tt.wantEmotion || confidence != tt.wantConfidence {
+				t.Errorf("dominantEmotion = %s, %v, want %s, %v", emotion, confidence, tt.wantEmotion, tt.wantConfidence)
+			}
+		})
+	}
+}
+
+func TestFaceScoresProtoRoundTrip(t *testing.T) {
+	scores := FaceScores{
+		Joy:          Likelihood_LIKELIHOOD_VERY_LIKELY,
+		Sorrow:       Likelihood_LIKELIHOOD_VERY_UNLIKELY,
+		Anger:        Likelihood_LIKELIHOOD_UNLIKELY,
+		Surprise:     Likelihood_LIKELIHOOD_POSSIBLE,
+		Blurred:      Likelihood_LIKELIHOOD_LIKELY,
+		Headwear:     Likelihood_LIKELIHOOD_UNKNOWN,
+		UnderExposed: Likelihood_LIKELIHOOD_VERY_UNLIKELY,
+	}
+	face := toProtoFace(&FaceAnalysisResult{Emotion: "Joy", Confidence: 0.95, Scores: scores, DetectionConfidence: 0.9})
+
+	if got := faceScoresFromProto(face); got != scores {
+		t.Errorf("faceScoresFromProto = %+v, want %+v", got, scores)
+	}
+	if got := face.Emotions.Surprise.Probability; got != 0.5 {
+		t.Errorf("POSSIBLE olasılığı = %v, want 0.5", got)
+	}
+	// Derecesi olmayan bir yüz de proto'dan güvenle okunur.
+	if got := faceScoresFromProto(&FaceAnalysis{}); got != (FaceScores{}) {
+		t.Errorf("boş yüzün dereceleri = %+v, want sıfır", got)
+	}
+}
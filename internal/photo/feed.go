@@ -0,0 +1,372 @@
+package photo
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"math/rand"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"myphotoapp/config"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// defaultHotHalfLife, yapılandırmada yarı ömür verilmediğinde "hot" sıralamasında kullanılır.
+const defaultHotHalfLife = 24 * time.Hour
+
+// FeedRanker, akış sorgusunun sıralamasını belirleyen stratejidir. Fotoğraflar puana, eşitlikte ID'ye göre büyükten
+// küçüğe sıralanır. Puan yalnızca fotoğraf verisine ve sayfa belirtecindeki anlık görüntü zamanı ile tohuma bağlıdır;
+// sonraki sayfalar son kaydın puanı ve ID'sinden devam ettiğinden sayfalar kaymaz.
+type FeedRanker interface {
+	// Score, "p" takma adlı photos tablosu için float8 sıralama puanını hesaplayan SQL ifadesini üretir ve
+	// parametreleri arg ile ekler.
+	Score(arg func(any) string, page pageToken) string
+}
+
+// recencyRanker, fotoğrafları yeniden eskiye sıralar.
+type recencyRanker struct{}
+
+func (recencyRanker) Score(arg func(any) string, page pageToken) string {
+	return "EXTRACT(EPOCH FROM p.upload_time)::float8"
+}
+
+// emotionWeights, duygu etiketlerinin sıralamadaki ağırlıklarıdır.
+type emotionWeights struct {
+	byEmotion map[string]float64 // Küçük harfli duygu etiketi -> ağırlık
+	fallback  float64            // Listede olmayan duyguların ağırlığı
+}
+
+// scoreSQL, bir fotoğrafın duygu puanını hesaplayan SQL ifadesini üretir: yüzlerin güvenilirliği ile duygu
+// ağırlığının çarpımlarının ortalaması. Yüz kaydı olmayan eski fotoğraflarda photos tablosundaki tek duygu
+// kullanılır; yüzü olmayan fotoğrafların puanı 0'dır.
+func (w emotionWeights) scoreSQL(arg func(any) string) string {
+	return "COALESCE((SELECT avg(f.confidence * " + w.caseSQL(arg, "f.emotion") + ") FROM photo_faces f WHERE f.photo_id = p.id), " +
+		"p.confidence * " + w.caseSQL(arg, "p.emotion") + ", 0)"
+}
+
+func (w emotionWeights) caseSQL(arg func(any) string, column string) string {
+	emotions := make([]string, 0, len(w.byEmotion))
+	for emotion := range w.byEmotion {
+		emotions = append(emotions, emotion)
+	}
+	sort.Strings(emotions)
+
+	var sb strings.Builder
+	sb.WriteString("CASE lower(" + column + ")")
+	for _, emotion := range emotions {
+		sb.WriteString(" WHEN " + arg(emotion) + "::text THEN " + arg(w.byEmotion[emotion]) + "::float8")
+	}
+	sb.WriteString(" ELSE " + arg(w.fallback) + "::float8 END")
+	return sb.String()
+}
+
+// emotionRanker, fotoğrafları ağırlıklı duygu puanına göre sıralar.
+type emotionRanker struct {
+	weights emotionWeights
+}
+
+func (r emotionRanker) Score(arg func(any) string, page pageToken) string {
+	return r.weights.scoreSQL(arg)
+}
+
+// hotRanker, duygu puanını yüklenmeden bu yana geçen sürenin yarı ömürle azalttığı yenilik puanıyla toplar:
+//
+//	puan = emotionWeight * duygu + recencyWeight * 2^(-yaş / halfLife)
+//
+// Yaş, sayfa belirtecindeki anlık görüntü zamanına göre ölçüldüğünden sayfalar arasında değişmez.
+type hotRanker struct {
+	weights       emotionWeights
+	emotionWeight float64
+	recencyWeight float64
+	halfLife      time.Duration
+}
+
+func (r hotRanker) Score(arg func(any) string, page pageToken) string {
+	age := "GREATEST(EXTRACT(EPOCH FROM (" + arg(time.Unix(page.Snapshot, 0).UTC()) + "::timestamp - p.upload_time))::float8, 0)"
+	return arg(r.emotionWeight) + "::float8 * " + r.weights.scoreSQL(arg) +
+		" + " + arg(r.recencyWeight) + "::float8 * power(2, -" + age + " / " + arg(r.halfLife.Seconds()) + "::float8)"
+}
+
+// randomRanker, fotoğrafları ID ve tohumun özetine göre karıştırır. Aynı tohum her zaman aynı sırayı verir.
+// Özetin ilk 52 biti puan olarak kullanılır; bu değer float8'e kayıpsız sığdığından sayfa belirtecinden aynen döner.
+type randomRanker struct{}
+
+func (randomRanker) Score(arg func(any) string, page pageToken) string {
+	return "('x' || substr(md5(p.id::text || ':' || " + arg(strconv.FormatInt(page.Seed, 10)) + "::text), 1, 13))::bit(52)::bigint::float8"
+}
+
+// FeedRankers, yapılandırmadan oluşturulan sıralama stratejilerini ve varsayılan stratejiyi tutar.
+type FeedRankers struct {
+	rankers     map[FeedRanking]FeedRanker
+	defaultKind FeedRanking
+}
+
+// NewFeedRankers, yapılandırmadaki ağırlıkları doğrular ve tüm sıralama stratejilerini oluşturur.
+func NewFeedRankers(cfg config.RankingConfig) (*FeedRankers, error) {
+	weights := emotionWeights{byEmotion: make(map[string]float64, len(cfg.EmotionWeights)), fallback: cfg.DefaultEmotionWeight}
+	if weights.fallback < 0 {
+		return nil, fmt.Errorf("varsayılan duygu ağırlığı negatif olamaz")
+	}
+	for emotion, weight := range cfg.EmotionWeights {
+		if weight < 0 {
+			return nil, fmt.Errorf("%s duygusunun ağırlığı negatif olamaz", emotion)
+		}
+		weights.byEmotion[strings.ToLower(emotion)] = weight
+	}
+
+	hot := hotRanker{
+		weights:       weights,
+		emotionWeight: cfg.Hot.EmotionWeight,
+		recencyWeight: cfg.Hot.RecencyWeight,
+		halfLife:      cfg.Hot.HalfLife,
+	}
+	if hot.emotionWeight < 0 || hot.recencyWeight < 0 {
+		return nil, fmt.Errorf("hot sıralama ağırlıkları negatif olamaz")
+	}
+	if hot.halfLife <= 0 {
+		hot.halfLife = defaultHotHalfLife
+	}
+
+	r := &FeedRankers{
+		rankers: map[FeedRanking]FeedRanker{
+			FeedRanking_FEED_RANKING_RECENCY: recencyRanker{},
+			FeedRanking_FEED_RANKING_EMOTION: emotionRanker{weights: weights},
+			FeedRanking_FEED_RANKING_HOT:     hot,
+			FeedRanking_FEED_RANKING_RANDOM:  randomRanker{},
+		},
+		defaultKind: FeedRanking_FEED_RANKING_RECENCY,
+	}
+	if cfg.Default != "" {
+		value, ok := FeedRanking_value["FEED_RANKING_"+strings.ToUpper(cfg.Default)]
+		if !ok || FeedRanking(value) == FeedRanking_FEED_RANKING_UNSPECIFIED {
+			return nil, fmt.Errorf("bilinmeyen varsayılan sıralama: %q", cfg.Default)
+		}
+		r.defaultKind = FeedRanking(value)
+	}
+	return r, nil
+}
+
+// Resolve, istekteki sıralama türünü stratejiye çevirir. Belirtilmemişse varsayılan strateji kullanılır.
+func (r *FeedRankers) Resolve(kind FeedRanking) (FeedRanking, FeedRanker, error) {
+	if kind == FeedRanking_FEED_RANKING_UNSPECIFIED {
+		kind = r.defaultKind
+	}
+	ranker, ok := r.rankers[kind]
+	if !ok {
+		return kind, nil, status.Errorf(codes.InvalidArgument, "bilinmeyen sıralama: %v", kind)
+	}
+	return kind, ranker, nil
+}
+
+// newRandomSeed, istemci tohum vermediğinde rastgele sıralama için sıfırdan farklı bir tohum üretir.
+func newRandomSeed() int64 {
+	return rand.Int63n(1<<62) + 1
+}
+
+// feedFilter, GetImageFeed filtrelerinin doğrulanmış ve normalleştirilmiş hâlidir.
+type feedFilter struct {
+	Emotions      []string // Küçük harfe çevrilmiş, sıralı duygu adları
+	MinConfidence float64
+	After         time.Time
+	Before        time.Time
+	TimeField     FeedTimeField
+	MinFaces      int
+	MaxFaces      int // 0 ise üst sınır yoktur
+	FacePresence  FacePresence
+}
+
+// newFeedFilter, istekteki filtreyi doğrular. Filtre yoksa boş bir feedFilter döner.
+func newFeedFilter(req *FeedFilter) (feedFilter, error) {
+	var f feedFilter
+	if req == nil {
+		return f, nil
+	}
+
+	seen := make(map[string]bool, len(req.Emotions))
+	for _, emotion := range req.Emotions {
+		emotion = strings.ToLower(strings.TrimSpace(emotion))
+		if emotion != "" && !seen[emotion] {
+			seen[emotion] = true
+			f.Emotions = append(f.Emotions, emotion)
+		}
+	}
+	sort.Strings(f.Emotions)
+
+	if req.MinConfidence < 0 || req.MinConfidence > 1 {
+		return f, status.Error(codes.InvalidArgument, "min_confidence 0 ile 1 arasında olmalıdır")
+	}
+	f.MinConfidence = float64(req.MinConfidence)
+
+	if _, ok := FeedTimeField_name[int32(req.TimeField)]; !ok {
+		return f, status.Errorf(codes.InvalidArgument, "bilinmeyen zaman alanı: %v", req.TimeField)
+	}
+	f.TimeField = req.TimeField
+	if req.TimeAfter > 0 {
+		f.After = time.Unix(req.TimeAfter, 0)
+	}
+	if req.TimeBefore > 0 {
+		f.Before = time.Unix(req.TimeBefore, 0)
+	}
+	if !f.After.IsZero() && !f.Before.IsZero() && !f.After.Before(f.Before) {
+		return f, status.Error(codes.InvalidArgument, "time_after, time_before değerinden önce olmalıdır")
+	}
+
+	if req.MinFaces < 0 || req.MaxFaces < 0 {
+		return f, status.Error(codes.InvalidArgument, "yüz sayısı sınırları negatif olamaz")
+	}
+	if req.MaxFaces > 0 && req.MaxFaces < req.MinFaces {
+		return f, status.Error(codes.InvalidArgument, "max_faces, min_faces değerinden küçük olamaz")
+	}
+	f.MinFaces, f.MaxFaces = int(req.MinFaces), int(req.MaxFaces)
+
+	if _, ok := FacePresence_name[int32(req.FacePresence)]; !ok {
+		return f, status.Errorf(codes.InvalidArgument, "bilinmeyen yüz filtresi: %v", req.FacePresence)
+	}
+	f.FacePresence = req.FacePresence
+	if f.FacePresence == FacePresence_FACE_PRESENCE_WITHOUT_FACES &&
+		(f.MinFaces > 0 || len(f.Emotions) > 0 || f.MinConfidence > 0) {
+		return f, status.Error(codes.InvalidArgument, "yüzsüz fotoğraf filtresi yüz koşullarıyla birlikte kullanılamaz")
+	}
+	return f, nil
+}
+
+// digest, filtrenin sayfa belirtecine gömülecek özetini döndürür.
+func (f feedFilter) digest() string {
+	return strings.Join([]string{
+		strings.Join(f.Emotions, ","),
+		strconv.FormatFloat(f.MinConfidence, 'f', -1, 64),
+		strconv.FormatInt(unixOrZero(f.After), 10),
+		strconv.FormatInt(unixOrZero(f.Before), 10),
+		f.TimeField.String(),
+		strconv.Itoa(f.MinFaces),
+		strconv.Itoa(f.MaxFaces),
+		f.FacePresence.String(),
+	}, "|")
+}
+
+// conditions, filtreyi "p" takma adlı photos tablosu için WHERE koşullarına çevirir.
+func (f feedFilter) conditions(arg func(any) string) []string {
+	var conds []string
+
+	timeColumn := "p.upload_time"
+	if f.TimeField == FeedTimeField_FEED_TIME_CAPTURE {
+		timeColumn = "p.captured_at"
+		conds = append(conds, "p.captured_at IS NOT NULL")
+	}
+	if !f.After.IsZero() {
+		conds = append(conds, timeColumn+" >= "+arg(f.After.UTC()))
+	}
+	if !f.Before.IsZero() {
+		conds = append(conds, timeColumn+" < "+arg(f.Before.UTC()))
+	}
+
+	// Duygu ve güvenilirlik aynı yüzde sağlanmalıdır. Yüz kaydı olmayan eski fotoğraflarda photos tablosundaki
+	// tek duygu kullanılır.
+	if len(f.Emotions) > 0 || f.MinConfidence > 0 {
+		var faceConds, legacyConds []string
+		if len(f.Emotions) > 0 {
+			emotions := arg(f.Emotions)
+			faceConds = append(faceConds, "lower(f.emotion) = ANY("+emotions+")")
+			legacyConds = append(legacyConds, "lower(p.emotion) = ANY("+emotions+")")
+		}
+		if f.MinConfidence > 0 {
+			minConfidence := arg(f.MinConfidence)
+			faceConds = append(faceConds, "f.confidence >= "+minConfidence)
+			legacyConds = append(legacyConds, "p.confidence >= "+minConfidence)
+		}
+		conds = append(conds, "(EXISTS (SELECT 1 FROM photo_faces f WHERE f.photo_id = p.id AND "+strings.Join(faceConds, " AND ")+")"+
+			" OR (NOT EXISTS (SELECT 1 FROM photo_faces f WHERE f.photo_id = p.id) AND "+strings.Join(legacyConds, " AND ")+"))")
+	}
+
+	switch f.FacePresence {
+	case FacePresence_FACE_PRESENCE_WITH_FACES:
+		conds = append(conds, "p.face_count > 0")
+	case FacePresence_FACE_PRESENCE_WITHOUT_FACES:
+		conds = append(conds, "p.face_count = 0")
+	}
+	if f.MinFaces > 0 {
+		conds = append(conds, "p.face_count >= "+arg(f.MinFaces))
+	}
+	if f.MaxFaces > 0 {
+		conds = append(conds, "p.face_count <= "+arg(f.MaxFaces))
+	}
+	return conds
+}
+
+// feedQuery, akış sorgusunun parametreleridir.
+type feedQuery struct {
+	Ranker FeedRanker
+	Filter feedFilter
+	Page   pageToken
+	Limit  int
+}
+
+// QueryFeed, anlık görüntü zamanından önce yüklenmiş, denetimden geçmiş ve filtreye uyan fotoğrafların bir
+// sayfasını verilen stratejiyle sıralayarak veritabanından çeker ve her fotoğrafın sıralama puanını döndürür.
+// Belirteçte bir önceki sayfanın son kaydı varsa sayfa ondan sonra başlar; yoksa sayfa numarasından gelen
+// başlangıç indeksi kullanılır.
+func QueryFeed(ctx context.Context, q feedQuery) ([]*UploadedImage, []float64, error) {
+	defer prometheus.NewTimer(feedQueryDuration).ObserveDuration()
+
+	var args []any
+	arg := func(v any) string {
+		args = append(args, v)
+		return "$" + strconv.Itoa(len(args))
+	}
+
+	conds := []string{
+		"p.upload_time <= " + arg(time.Unix(q.Page.Snapshot, 0).UTC()),
+		"p.moderation_status = 'ACCEPTED'",
+	}
+	score := q.Ranker.Score(arg, q.Page)
+	offset := q.Page.Offset
+	if q.Page.After > 0 {
+		conds = append(conds, "("+score+", p.id) < ("+arg(q.Page.Score)+"::float8, "+arg(q.Page.After)+")")
+		offset = 0
+	}
+	conds = append(conds, q.Filter.conditions(arg)...)
+
+	query := `SELECT ` + photoColumns + `, ` + score + ` AS feed_score FROM photos p
+        WHERE ` + strings.Join(conds, " AND ") + `
+        ORDER BY feed_score DESC, p.id DESC
+        LIMIT ` + arg(q.Limit) + ` OFFSET ` + arg(offset)
+
+	rows, err := db.QueryContext(ctx, query, args...)
+	if err != nil {
+		slog.ErrorContext(ctx, "Akış alınamadı", "error", err)
+		return nil, nil, err
+	}
+	defer rows.Close()
+
+	var images []*UploadedImage
+	var scores []float64
+	for rows.Next() {
+		var score float64
+		img, err := scanPhoto(rows, &score)
+		if err != nil {
+			slog.ErrorContext(ctx, "Fotoğraf alınamadı", "error", err)
+			return nil, nil, err
+		}
+		images = append(images, img)
+		scores = append(scores, score)
+	}
+	return images, scores, rows.Err()
+}
+
+// nextFeedToken, akışın sonraki sayfası için son döndürülen fotoğrafın puanını ve ID'sini taşıyan belirteci üretir.
+func nextFeedToken(current pageToken, last *UploadedImage, lastScore float64) (string, error) {
+	id, err := strconv.ParseInt(last.Id, 10, 64)
+	if err != nil {
+		return "", err
+	}
+	current.Offset = 0
+	current.After = id
+	current.Score = lastScore
+	return encodePageToken(current), nil
+}
@@ -0,0 +1,153 @@
+package photo
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"myphotoapp/config"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestNewFeedRankers(t *testing.T) {
+	valid := config.RankingConfig{Default: "hot", EmotionWeights: map[string]float64{"Joy": 1}}
+	r, err := NewFeedRankers(valid)
+	if err != nil {
+		t.Fatalf("NewFeedRankers: %v", err)
+	}
+	kind, ranker, err := r.Resolve(FeedRanking_FEED_RANKING_UNSPECIFIED)
+	if err != nil || kind != FeedRanking_FEED_RANKING_HOT {
+		t.Errorf("Resolve(UNSPECIFIED) = %v, %v, want HOT", kind, err)
+	}
+	if hot, ok := ranker.(hotRanker); !ok || hot.halfLife != defaultHotHalfLife || hot.weights.byEmotion["joy"] != 1 {
+		t.Errorf("hot sıralayıcı = %+v, want varsayılan yarı ömür ve küçük harfli ağırlık", ranker)
+	}
+	if _, _, err := r.Resolve(FeedRanking(99)); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("Resolve(99) = %v, want InvalidArgument", err)
+	}
+
+	invalid := []config.RankingConfig{
+		{Default: "popular"},
+		{Default: "unspecified"},
+		{DefaultEmotionWeight: -1},
+		{EmotionWeights: map[string]float64{"joy": -0.5}},
+	}
+	for _, cfg := range invalid {
+		if _, err := NewFeedRankers(cfg); err == nil {
+			t.Errorf("NewFeedRankers(%+v) hata vermedi", cfg)
+		}
+	}
+}
+
+func TestFeedRankerScoreIsDeterministic(t *testing.T) {
+	// Aynı belirteçle üretilen puan ifadesi ve parametreleri değişmemelidir; yoksa sayfalar kayar.
+	r, err := NewFeedRankers(config.RankingConfig{EmotionWeights: map[string]float64{"joy": 1, "sorrow": 0.4}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	page := pageToken{Snapshot: 1700000000, Seed: 42}
+	for _, kind := range []FeedRanking{FeedRanking_FEED_RANKING_RECENCY, FeedRanking_FEED_RANKING_EMOTION,
+		FeedRanking_FEED_RANKING_HOT, FeedRanking_FEED_RANKING_RANDOM} {
+		_, ranker, err := r.Resolve(kind)
+		if err != nil {
+			t.Fatal(err)
+		}
+		sql1, args1 := scoreSQL(ranker, page)
+		sql2, args2 := scoreSQL(ranker, page)
+		if sql1 != sql2 || strings.Join(args1, ",") != strings.Join(args2, ",") {
+			t.Errorf("%v: puan ifadesi tekrarlanabilir değil", kind)
+		}
+	}
+
+	_, random := scoreSQL(randomRanker{}, page)
+	if len(random) != 1 || random[0] != "42" {
+		t.Errorf("rastgele sıralama parametreleri = %v, want tohum 42", random)
+	}
+}
+
+// scoreSQL, sıralayıcının puan ifadesini ve metne çevrilmiş parametrelerini döndürür.
+func scoreSQL(ranker FeedRanker, page pageToken) (string, []string) {
+	var args []string
+	sql := ranker.Score(func(v any) string {
+		args = append(args, fmt.Sprint(v))
+		return "$" + strconv.Itoa(len(args))
+	}, page)
+	return sql, args
+}
+
+func TestNextFeedToken(t *testing.T) {
+	filter := filterDigest("feed", "FEED_RANKING_RANDOM", "0")
+	page := pageToken{Offset: 20, Snapshot: 1700000000, Filter: filter, Seed: 7}
+	// Rastgele sıralamanın 52 bitlik puanı belirteçten kayıpsız dönmelidir.
+	score := float64(1<<52 - 1)
+
+	token, err := nextFeedToken(page, &UploadedImage{Id: "31"}, score)
+	if err != nil {
+		t.Fatalf("nextFeedToken: %v", err)
+	}
+	got, err := decodePageToken(token, filter)
+	if err != nil {
+		t.Fatalf("decodePageToken: %v", err)
+	}
+	want := pageToken{Snapshot: 1700000000, Filter: filter, Seed: 7, After: 31, Score: score}
+	if got != want {
+		t.Errorf("belirteç = %+v, want %+v", got, want)
+	}
+
+	if _, err := nextFeedToken(page, &UploadedImage{Id: "abc"}, math.Pi); err == nil {
+		t.Error("sayısal olmayan ID için hata beklenirdi")
+	}
+}
+
+func TestNewFeedFilter(t *testing.T) {
+	f, err := newFeedFilter(&FeedFilter{Emotions: []string{" Joy", "sorrow", "joy", ""}, MinConfidence: 0.5})
+	if err != nil {
+		t.Fatalf("newFeedFilter: %v", err)
+	}
+	if strings.Join(f.Emotions, ",") != "joy,sorrow" {
+		t.Errorf("duygular = %v, want [joy sorrow]", f.Emotions)
+	}
+
+	invalid := []struct {
+		name   string
+		filter *FeedFilter
+	}{
+		{"güven sınır dışı", &FeedFilter{MinConfidence: 1.5}},
+		{"ters zaman aralığı", &FeedFilter{TimeAfter: 200, TimeBefore: 100}},
+		{"negatif yüz sayısı", &FeedFilter{MinFaces: -1}},
+		{"ters yüz sayısı aralığı", &FeedFilter{MinFaces: 3, MaxFaces: 2}},
+		{"bilinmeyen zaman alanı", &FeedFilter{TimeField: FeedTimeField(9)}},
+		{"yüzsüz ve duygu", &FeedFilter{FacePresence: FacePresence_FACE_PRESENCE_WITHOUT_FACES, Emotions: []string{"joy"}}},
+	}
+	for _, tt := range invalid {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := newFeedFilter(tt.filter); status.Code(err) != codes.InvalidArgument {
+				t.Errorf("newFeedFilter = %v, want InvalidArgument", err)
+			}
+		})
+	}
+}
+
+func TestValidateCapturedAtBeforeAnalysis(t *testing.T) {
+	// Geçersiz çekim zamanı Vision API çağrılmadan reddedilir; hizmette Vision API istemcisi yoktur.
+	s := &PhotoService{}
+	future := now().Add(48 * time.Hour).Unix()
+
+	for _, capturedAt := range []int64{-1, future} {
+		req := &UploadedImage{Url: "https://example.com/a.jpg", CapturedAt: capturedAt}
+		if _, err := s.UploadImage(peerContext("203.0.113.7"), req); status.Code(err) != codes.InvalidArgument {
+			t.Errorf("UploadImage(captured_at=%d) = %v, want InvalidArgument", capturedAt, err)
+		}
+		if _, err := s.UpdateImageDetail(peerContext("203.0.113.7"), req); status.Code(err) != codes.InvalidArgument {
+			t.Errorf("UpdateImageDetail(captured_at=%d) = %v, want InvalidArgument", capturedAt, err)
+		}
+	}
+	if err := validateCapturedAt(now().Add(time.Hour).Unix()); err != nil {
+		t.Errorf("saat farkı içindeki çekim zamanı reddedildi: %v", err)
+	}
+}
@@ -0,0 +1,277 @@
+package photo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"strings"
+	"syscall"
+	"time"
+
+	"myphotoapp/config"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	defaultFetchTimeout      = 10 * time.Second
+	defaultFetchMaxRedirects = 3
+	defaultFetchMaxBytes     = 20 << 20 // Vision API'nin içerik olarak kabul ettiği en büyük görüntü
+)
+
+// defaultFetchSchemes, yapılandırma verilmediğinde izin verilen URL şemalarıdır.
+var defaultFetchSchemes = []string{"http", "https"}
+
+// fetchableImageTypes, içeriğin ilk baytlarından tanınan ve Vision API'ye gönderilebilen görüntü türleridir.
+var fetchableImageTypes = map[string]bool{
+	"image/jpeg": true,
+	"image/png":  true,
+	"image/gif":  true,
+	"image/webp": true,
+	"image/bmp":  true,
+}
+
+// reservedPrefixes, netip.Addr yöntemlerinin kapsamadığı, genel İnternet'te yönlendirilmeyen adres bloklarıdır.
+var reservedPrefixes = []netip.Prefix{
+	netip.MustParsePrefix("0.0.0.0/8"),      // "Bu ağ"
+	netip.MustParsePrefix("100.64.0.0/10"),  // Taşıyıcı sınıfı NAT
+	netip.MustParsePrefix("192.0.0.0/24"),   // IETF protokol atamaları
+	netip.MustParsePrefix("198.18.0.0/15"),  // Kıyaslama ağları
+	netip.MustParsePrefix("240.0.0.0/4"),    // Ayrılmış ve yayın
+	netip.MustParsePrefix("64:ff9b::/96"),   // NAT64; iç IPv4 adreslerine çevrilebilir
+	netip.MustParsePrefix("64:ff9b:1::/48"), // Yerel NAT64
+	netip.MustParsePrefix("2001:db8::/32"),  // Belgeleme
+	netip.MustParsePrefix("100::/64"),       // Atılacak trafik
+	netip.MustParsePrefix("2002::/16"),      // 6to4; gömülü IPv4 adresi iç ağa işaret edebilir
+	netip.MustParsePrefix("fec0::/10"),      // Eski site-yerel adresler
+	netip.MustParsePrefix("2001::/32"),      // Teredo
+	netip.MustParsePrefix("192.88.99.0/24"), // 6to4 aktarımı
+	netip.MustParsePrefix("255.255.255.255/32"),
+}
+
+// blockedAddressError, bağlanılmak istenen adres iç ağa işaret ettiğinde döner.
+type blockedAddressError struct {
+	addr netip.Addr
+}
+
+func (e *blockedAddressError) Error() string {
+	return fmt.Sprintf("%s adresine bağlanılamaz", e.addr)
+}
+
+// FetchedImage, güvenli biçimde indirilmiş bir görüntüdür.
+type FetchedImage struct {
+	Content     []byte
+	ContentType string // İçeriğin ilk baytlarından tanınan tür
+	ETag        string // Sunucunun bildirdiği ETag; bildirmediyse boş
+}
+
+// ImageFetcher, kullanıcının verdiği URL'lerden görüntüleri sunucunun iç ağına istek yapılmasına izin vermeden
+// indirir. Adres kontrolü DNS çözümlemesinden sonra, bağlantı kurulurken yapıldığından yönlendirmeler ve DNS
+// yeniden bağlama saldırıları da engellenir.
+type ImageFetcher struct {
+	client   *http.Client
+	schemes  map[string]bool
+	maxBytes int64
+	allowed  []netip.Prefix
+}
+
+// NewImageFetcher, yapılandırmaya göre yeni bir ImageFetcher oluşturur. AllowedCIDRs ile iç ağdaki belirli
+// bloklara (örneğin, testlerde httptest sunucusunun dinlediği 127.0.0.0/8) izin verilebilir.
+func NewImageFetcher(cfg config.FetchConfig) (*ImageFetcher, error) {
+	f := &ImageFetcher{schemes: make(map[string]bool), maxBytes: cfg.MaxBytes}
+	if f.maxBytes <= 0 {
+		f.maxBytes = defaultFetchMaxBytes
+	}
+
+	schemes := cfg.Schemes
+	if len(schemes) == 0 {
+		schemes = defaultFetchSchemes
+	}
+	for _, scheme := range schemes {
+		scheme = strings.ToLower(scheme)
+		if scheme != "http" && scheme != "https" {
+			return nil, fmt.Errorf("desteklenmeyen URL şeması: %q", scheme)
+		}
+		f.schemes[scheme] = true
+	}
+
+	for _, cidr := range cfg.AllowedCIDRs {
+		prefix, err := netip.ParsePrefix(cidr)
+		if err != nil {
+			return nil, fmt.Errorf("geçersiz izinli ağ %q: %v", cidr, err)
+		}
+		f.allowed = append(f.allowed, prefix.Masked())
+	}
+
+	timeout := cfg.Timeout
+	if timeout <= 0 {
+		timeout = defaultFetchTimeout
+	}
+	maxRedirects := cfg.MaxRedirects
+	if maxRedirects <= 0 {
+		maxRedirects = defaultFetchMaxRedirects
+	}
+
+	dialer := &net.Dialer{Timeout: timeout, Control: f.checkDial}
+	f.client = &http.Client{
+		Timeout: timeout,
+		Transport: &http.Transport{
+			// Vekil sunucu kullanılırsa adres kontrolü vekile uygulanır; bu yüzden vekil kullanılmaz.
+			Proxy:                 nil,
+			DialContext:           dialer.DialContext,
+			TLSHandshakeTimeout:   timeout,
+			ResponseHeaderTimeout: timeout,
+			MaxIdleConns:          16,
+			IdleConnTimeout:       90 * time.Second,
+		},
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			if len(via) > maxRedirects {
+				return fmt.Errorf("%d yönlendirmeden fazlası izlenmez", maxRedirects)
+			}
+			return f.checkURL(req.URL)
+		},
+	}
+	return f, nil
+}
+
+// checkURL, URL'nin şemasının izinli olduğunu ve bir sunucu adı içerdiğini doğrular.
+func (f *ImageFetcher) checkURL(u *url.URL) error {
+	if !f.schemes[strings.ToLower(u.Scheme)] {
+		return fmt.Errorf("%q şemasına izin verilmiyor", u.Scheme)
+	}
+	if u.Hostname() == "" {
+		return errors.New("URL sunucu adı içermiyor")
+	}
+	if u.User != nil {
+		return errors.New("URL kullanıcı bilgisi içeremez")
+	}
+	return nil
+}
+
+// checkDial, çözümlenmiş adrese bağlanmadan önce adresin iç ağa ait olmadığını doğrular.
+func (f *ImageFetcher) checkDial(network, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	addr, err := netip.ParseAddr(host)
+	if err != nil {
+		return err
+	}
+	addr = addr.Unmap().WithZone("")
+	if f.blocked(addr) {
+		return &blockedAddressError{addr: addr}
+	}
+	return nil
+}
+
+// blocked, adresin izinli bloklarda değilse ve genel İnternet'te yönlendirilmiyorsa engellenmesi gerektiğini
+// bildirir.
+func (f *ImageFetcher) blocked(addr netip.Addr) bool {
+	for _, prefix := range f.allowed {
+		if prefix.Contains(addr) {
+			return false
+		}
+	}
+	if addr.IsLoopback() || addr.IsPrivate() || addr.IsLinkLocalUnicast() || addr.IsLinkLocalMulticast() ||
+		addr.IsInterfaceLocalMulticast() || addr.IsMulticast() || addr.IsUnspecified() {
+		return true
+	}
+	for _, prefix := range reservedPrefixes {
+		if prefix.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+// Fetch, görüntüyü indirir ve içeriğin desteklenen bir görüntü olduğunu doğrular. URL'den kaynaklanan hatalar
+// InvalidArgument olarak döner.
+func (f *ImageFetcher) Fetch(ctx context.Context, imageURI string) (*FetchedImage, error) {
+	u, err := url.Parse(imageURI)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "geçersiz görüntü URL'si: %v", err)
+	}
+	if err := f.checkURL(u); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "geçersiz görüntü URL'si: %v", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "geçersiz görüntü URL'si: %v", err)
+	}
+	req.Header.Set("Accept", "image/*")
+
+	resp, err := f.client.Do(req)
+	if err != nil {
+		if ctx.Err() != nil {
+			return nil, status.FromContextError(ctx.Err()).Err()
+		}
+		var blocked *blockedAddressError
+		if errors.As(err, &blocked) {
+			return nil, status.Errorf(codes.InvalidArgument, "görüntü adresine erişim engellendi: %v", blocked)
+		}
+		return nil, status.Errorf(codes.InvalidArgument, "görüntü indirilemedi: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, status.Errorf(codes.InvalidArgument, "görüntü indirilemedi: %s", resp.Status)
+	}
+	if resp.ContentLength > f.maxBytes {
+		return nil, status.Errorf(codes.InvalidArgument, "görüntü %d bayttan büyük", f.maxBytes)
+	}
+	if header := resp.Header.Get("Content-Type"); header != "" {
+		mediaType, _, err := mime.ParseMediaType(header)
+		if err != nil || !(strings.HasPrefix(mediaType, "image/") || mediaType == "application/octet-stream") {
+			return nil, status.Errorf(codes.InvalidArgument, "URL bir görüntüye işaret etmiyor (%s)", header)
+		}
+	}
+
+	content, err := io.ReadAll(io.LimitReader(resp.Body, f.maxBytes+1))
+	if err != nil {
+		if ctx.Err() != nil {
+			return nil, status.FromContextError(ctx.Err()).Err()
+		}
+		return nil, status.Errorf(codes.InvalidArgument, "görüntü indirilemedi: %v", err)
+	}
+	if int64(len(content)) > f.maxBytes {
+		return nil, status.Errorf(codes.InvalidArgument, "görüntü %d bayttan büyük", f.maxBytes)
+	}
+
+	// Sunucunun bildirdiği türe güvenilmez; tür içeriğin ilk baytlarından belirlenir.
+	contentType := http.DetectContentType(content)
+	if !fetchableImageTypes[contentType] {
+		return nil, status.Errorf(codes.InvalidArgument, "desteklenmeyen görüntü içeriği (%s)", contentType)
+	}
+	return &FetchedImage{Content: content, ContentType: contentType, ETag: resp.Header.Get("ETag")}, nil
+}
+
+// ETag, görüntüyü indirmeden HEAD isteğiyle sunucunun bildirdiği ETag'i döndürür. Sunucu ETag vermiyorsa ya
+// da istek başarısız olursa boş döner; hatalar görüntü indirilirken Fetch tarafından bildirilir.
+func (f *ImageFetcher) ETag(ctx context.Context, imageURI string) string {
+	u, err := url.Parse(imageURI)
+	if err != nil || f.checkURL(u) != nil {
+		return ""
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodHead, u.String(), nil)
+	if err != nil {
+		return ""
+	}
+	resp, err := f.client.Do(req)
+	if err != nil {
+		return ""
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return ""
+	}
+	return resp.Header.Get("ETag")
+}
@@ -0,0 +1,339 @@
+package photo
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"log/slog"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// maxGatewayBodyBytes, REST isteklerinde kabul edilen en büyük gövdedir.
+const maxGatewayBodyBytes = 1 << 20
+
+var (
+	gatewayMarshal   = protojson.MarshalOptions{}
+	gatewayUnmarshal = protojson.UnmarshalOptions{}
+)
+
+// httpStatusFromCode, gRPC durum kodunu karşılık gelen HTTP durum koduna çevirir.
+func httpStatusFromCode(code codes.Code) int {
+	switch code {
+	case codes.OK:
+		return http.StatusOK
+	case codes.Canceled:
+		return 499 // İstemci isteği kapattı
+	case codes.InvalidArgument, codes.FailedPrecondition, codes.OutOfRange:
+		return http.StatusBadRequest
+	case codes.DeadlineExceeded:
+		return http.StatusGatewayTimeout
+	case codes.NotFound:
+		return http.StatusNotFound
+	case codes.AlreadyExists, codes.Aborted:
+		return http.StatusConflict
+	case codes.PermissionDenied:
+		return http.StatusForbidden
+	case codes.Unauthenticated:
+		return http.StatusUnauthorized
+	case codes.ResourceExhausted:
+		return http.StatusTooManyRequests
+	case codes.Unimplemented:
+		return http.StatusNotImplemented
+	case codes.Unavailable:
+		return http.StatusServiceUnavailable
+	}
+	return http.StatusInternalServerError
+}
+
+// Gateway, PhotoService'i HTTP/JSON olarak sunar. İstekler gRPC sunucusuna iletildiğinden istek sınırları ve
+// diğer önleyiciler REST istemcilerine de uygulanır.
+type Gateway struct {
+	photos PhotoServiceClient
+	mux    *http.ServeMux
+}
+
+// NewGateway, verilen gRPC bağlantısı üzerinden çalışan yeni bir Gateway oluşturur.
+func NewGateway(conn grpc.ClientConnInterface) *Gateway {
+	g := &Gateway{photos: NewPhotoServiceClient(conn), mux: http.NewServeMux()}
+	g.mux.HandleFunc("/v1/images", g.handleImages)
+	g.mux.HandleFunc("/v1/images/", g.handleImage)
+	g.mux.HandleFunc("/v1/feed", g.handleFeed)
+	g.mux.HandleFunc("/v1/openapi.json", g.handleOpenAPI)
+	return g
+}
+
+func (g *Gateway) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	g.mux.ServeHTTP(w, r)
+}
+
+// handleImages, POST /v1/images isteğini UploadImage'e iletir.
+func (g *Gateway) handleImages(w http.ResponseWriter, r *http.Request) {
+	if !allowMethod(w, r, http.MethodPost) {
+		return
+	}
+	req := &UploadedImage{}
+	if err := readBody(r, req); err != nil {
+		writeError(w, err)
+		return
+	}
+	var header metadata.MD
+	resp, err := g.photos.UploadImage(outgoingContext(r), req, grpc.Header(&header))
+	respond(w, resp, header, err)
+}
+
+// handleImage, GET ve PATCH /v1/images/{id} isteklerini GetImageDetail ve UpdateImageDetail'e iletir.
+func (g *Gateway) handleImage(w http.ResponseWriter, r *http.Request) {
+	id := strings.TrimPrefix(r.URL.Path, "/v1/images/")
+	if id == "" || strings.Contains(id, "/") {
+		http.NotFound(w, r)
+		return
+	}
+	if !allowMethod(w, r, http.MethodGet, http.MethodPatch) {
+		return
+	}
+
+	req := &UploadedImage{}
+	if r.Method == http.MethodPatch {
+		if err := readBody(r, req); err != nil {
+			writeError(w, err)
+			return
+		}
+		if req.Id != "" && req.Id != id {
+			writeError(w, status.Errorf(codes.InvalidArgument, "gövdedeki id (%s) yoldaki id ile uyuşmuyor", req.Id))
+			return
+		}
+	} else if refresh := r.URL.Query().Get("refresh"); refresh != "" {
+		var err error
+		if req.Refresh, err = strconv.ParseBool(refresh); err != nil {
+			writeError(w, status.Errorf(codes.InvalidArgument, "geçersiz refresh değeri: %q", refresh))
+			return
+		}
+	}
+	req.Id = id
+
+	var header metadata.MD
+	var resp *UploadedImage
+	var err error
+	if r.Method == http.MethodPatch {
+		resp, err = g.photos.UpdateImageDetail(outgoingContext(r), req, grpc.Header(&header))
+	} else {
+		resp, err = g.photos.GetImageDetail(outgoingContext(r), req, grpc.Header(&header))
+	}
+	respond(w, resp, header, err)
+}
+
+// handleFeed, GET /v1/feed isteğini GetImageFeed'e iletir. İstek alanları sorgu parametrelerinden okunur;
+// iç içe alanlar noktayla (filter.emotions=Joy), tekrarlanan alanlar parametre tekrarlanarak verilir.
+func (g *Gateway) handleFeed(w http.ResponseWriter, r *http.Request) {
+	if !allowMethod(w, r, http.MethodGet) {
+		return
+	}
+	req := &GetImageFeedRequest{}
+	if err := bindQuery(req, r); err != nil {
+		writeError(w, err)
+		return
+	}
+	var header metadata.MD
+	resp, err := g.photos.GetImageFeed(outgoingContext(r), req, grpc.Header(&header))
+	respond(w, resp, header, err)
+}
+
+// handleOpenAPI, proto tanımlarından üretilen OpenAPI belgesini sunar.
+func (g *Gateway) handleOpenAPI(w http.ResponseWriter, r *http.Request) {
+	if !allowMethod(w, r, http.MethodGet) {
+		return
+	}
+	doc, err := OpenAPIDocument()
+	if err != nil {
+		writeError(w, status.Errorf(codes.Internal, "OpenAPI belgesi üretilemedi: %v", err))
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write(doc)
+}
+
+// allowMethod, istek yöntemi izinli değilse 405 döndürür.
+func allowMethod(w http.ResponseWriter, r *http.Request, methods ...string) bool {
+	for _, m := range methods {
+		if r.Method == m {
+			return true
+		}
+	}
+	w.Header().Set("Allow", strings.Join(methods, ", "))
+	writeStatus(w, http.StatusMethodNotAllowed, status.Newf(codes.Unimplemented, "%s yöntemi desteklenmiyor", r.Method))
+	return false
+}
+
+// outgoingContext, gRPC isteğinin bağlamını hazırlar. İstek sınırları ve sahiplik gerçek istemciye uygulansın
+// diye HTTP istemcisinin adresi istemci kimliği olarak, geçit belirteciyle birlikte iletilir. İstemcinin kendi
+// gönderdiği X-Client-Id doğrulanamadığı için kullanılmaz.
+func outgoingContext(r *http.Request) context.Context {
+	clientID := r.RemoteAddr
+	if host, _, err := net.SplitHostPort(r.RemoteAddr); err == nil {
+		clientID = host
+	}
+	md := metadata.Pairs(clientIDHeader, clientID, gatewayTokenHeader, gatewayToken)
+	if id := r.Header.Get(requestIDHeader); id != "" {
+		md.Set(requestIDHeader, id)
+	}
+	return metadata.NewOutgoingContext(r.Context(), md)
+}
+
+// readBody, JSON gövdesini protojson ile verilen mesaja çözer.
+func readBody(r *http.Request, msg proto.Message) error {
+	body, err := io.ReadAll(io.LimitReader(r.Body, maxGatewayBodyBytes+1))
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "istek gövdesi okunamadı: %v", err)
+	}
+	if len(body) > maxGatewayBodyBytes {
+		return status.Errorf(codes.InvalidArgument, "istek gövdesi %d bayttan büyük", maxGatewayBodyBytes)
+	}
+	if err := gatewayUnmarshal.Unmarshal(body, msg); err != nil {
+		return status.Errorf(codes.InvalidArgument, "geçersiz istek gövdesi: %v", err)
+	}
+	return nil
+}
+
+// respond, gRPC yanıtını ya da hatasını HTTP yanıtı olarak yazar.
+func respond(w http.ResponseWriter, resp proto.Message, header metadata.MD, err error) {
+	if retryAfter := header.Get(retryAfterHeader); len(retryAfter) > 0 {
+		w.Header().Set("Retry-After", retryAfter[0])
+	}
+	if id := header.Get(requestIDHeader); len(id) > 0 {
+		w.Header().Set("X-Request-ID", id[0])
+	}
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	body, err := gatewayMarshal.Marshal(resp)
+	if err != nil {
+		writeError(w, status.Errorf(codes.Internal, "yanıt kodlanamadı: %v", err))
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write(body)
+}
+
+// writeError, hatayı google.rpc.Status biçiminde, gRPC koduna karşılık gelen HTTP durumuyla yazar.
+func writeError(w http.ResponseWriter, err error) {
+	st := status.Convert(err)
+	writeStatus(w, httpStatusFromCode(st.Code()), st)
+}
+
+func writeStatus(w http.ResponseWriter, httpStatus int, st *status.Status) {
+	body, merr := gatewayMarshal.Marshal(st.Proto())
+	if merr != nil {
+		slog.Error("REST hata yanıtı kodlanamadı", "error", merr)
+		body = []byte(fmt.Sprintf(`{"code":%d}`, st.Code()))
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(httpStatus)
+	_, _ = w.Write(body)
+}
+
+// bindQuery, sorgu parametrelerini mesajın alanlarına yazar. Alanlar JSON ya da proto adlarıyla verilebilir.
+func bindQuery(msg proto.Message, r *http.Request) error {
+	for key, values := range r.URL.Query() {
+		if err := setQueryField(msg.ProtoReflect(), strings.Split(key, "."), values); err != nil {
+			return status.Errorf(codes.InvalidArgument, "geçersiz sorgu parametresi %q: %v", key, err)
+		}
+	}
+	return nil
+}
+
+func setQueryField(msg protoreflect.Message, path []string, values []string) error {
+	fields := msg.Descriptor().Fields()
+	fd := fields.ByJSONName(path[0])
+	if fd == nil {
+		fd = fields.ByName(protoreflect.Name(path[0]))
+	}
+	if fd == nil {
+		return fmt.Errorf("bilinmeyen alan")
+	}
+
+	if len(path) > 1 {
+		if fd.Kind() != protoreflect.MessageKind || fd.IsList() || fd.IsMap() {
+			return fmt.Errorf("%s iç içe bir alan değil", fd.Name())
+		}
+		return setQueryField(msg.Mutable(fd).Message(), path[1:], values)
+	}
+	if fd.IsMap() || fd.Kind() == protoreflect.MessageKind || fd.Kind() == protoreflect.GroupKind {
+		return fmt.Errorf("%s sorgu parametresiyle verilemez", fd.Name())
+	}
+
+	if fd.IsList() {
+		list := msg.Mutable(fd).List()
+		for _, s := range values {
+			v, err := parseQueryValue(fd, s)
+			if err != nil {
+				return err
+			}
+			list.Append(v)
+		}
+		return nil
+	}
+	if len(values) > 1 {
+		return fmt.Errorf("%s birden fazla kez verilemez", fd.Name())
+	}
+	v, err := parseQueryValue(fd, values[0])
+	if err != nil {
+		return err
+	}
+	msg.Set(fd, v)
+	return nil
+}
+
+// parseQueryValue, sorgu parametresini alanın türüne çevirir. Sayılabilir değerler adla ya da numarayla verilebilir.
+func parseQueryValue(fd protoreflect.FieldDescriptor, s string) (protoreflect.Value, error) {
+	switch fd.Kind() {
+	case protoreflect.BoolKind:
+		b, err := strconv.ParseBool(s)
+		return protoreflect.ValueOfBool(b), err
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		n, err := strconv.ParseInt(s, 10, 32)
+		return protoreflect.ValueOfInt32(int32(n)), err
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		n, err := strconv.ParseInt(s, 10, 64)
+		return protoreflect.ValueOfInt64(n), err
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		n, err := strconv.ParseUint(s, 10, 32)
+		return protoreflect.ValueOfUint32(uint32(n)), err
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		n, err := strconv.ParseUint(s, 10, 64)
+		return protoreflect.ValueOfUint64(n), err
+	case protoreflect.FloatKind:
+		f, err := strconv.ParseFloat(s, 32)
+		return protoreflect.ValueOfFloat32(float32(f)), err
+	case protoreflect.DoubleKind:
+		f, err := strconv.ParseFloat(s, 64)
+		return protoreflect.ValueOfFloat64(f), err
+	case protoreflect.StringKind:
+		return protoreflect.ValueOfString(s), nil
+	case protoreflect.BytesKind:
+		b, err := base64.StdEncoding.DecodeString(s)
+		return protoreflect.ValueOfBytes(b), err
+	case protoreflect.EnumKind:
+		if ev := fd.Enum().Values().ByName(protoreflect.Name(s)); ev != nil {
+			return protoreflect.ValueOfEnum(ev.Number()), nil
+		}
+		n, err := strconv.ParseInt(s, 10, 32)
+		if err != nil || fd.Enum().Values().ByNumber(protoreflect.EnumNumber(n)) == nil {
+			return protoreflect.Value{}, fmt.Errorf("bilinmeyen %s değeri", fd.Enum().Name())
+		}
+		return protoreflect.ValueOfEnum(protoreflect.EnumNumber(n)), nil
+	}
+	return protoreflect.Value{}, fmt.Errorf("desteklenmeyen alan türü %s", fd.Kind())
+}
@@ -0,0 +1,122 @@
+package photo
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// gatewayTestServer, fotoğraf sahibini isteği yapan istemci olarak döndüren, 404 ID'sinde NotFound veren bir
+// PhotoService'tir.
+type gatewayTestServer struct {
+	UnimplementedPhotoServiceServer
+}
+
+func (gatewayTestServer) GetImageDetail(ctx context.Context, req *UploadedImage) (*UploadedImage, error) {
+	if req.Id == "404" {
+		return nil, status.Errorf(codes.NotFound, "fotoğraf bulunamadı: %s", req.Id)
+	}
+	return &UploadedImage{Id: req.Id, OwnerId: clientIdentity(ctx)}, nil
+}
+
+// newTestGateway, yerel bir gRPC sunucusuna bağlı bir Gateway oluşturur.
+func newTestGateway(t *testing.T) *Gateway {
+	t.Helper()
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	srv := grpc.NewServer()
+	RegisterPhotoServiceServer(srv, gatewayTestServer{})
+	go srv.Serve(lis)
+	t.Cleanup(srv.Stop)
+
+	conn, err := grpc.Dial(lis.Addr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	return NewGateway(conn)
+}
+
+func TestGatewayGetImageDetail(t *testing.T) {
+	g := newTestGateway(t)
+
+	tests := []struct {
+		name       string
+		method     string
+		path       string
+		wantStatus int
+	}{
+		{"bulunan fotoğraf", http.MethodGet, "/v1/images/42", http.StatusOK},
+		{"bulunamayan fotoğraf", http.MethodGet, "/v1/images/404", http.StatusNotFound},
+		{"geçersiz refresh", http.MethodGet, "/v1/images/42?refresh=belki", http.StatusBadRequest},
+		{"desteklenmeyen yöntem", http.MethodDelete, "/v1/images/42", http.StatusMethodNotAllowed},
+		{"iç içe yol", http.MethodGet, "/v1/images/42/faces", http.StatusNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			g.ServeHTTP(rec, httptest.NewRequest(tt.method, tt.path, nil))
+			if rec.Code != tt.wantStatus {
+				t.Errorf("%s %s = %d, want %d: %s", tt.method, tt.path, rec.Code, tt.wantStatus, rec.Body)
+			}
+		})
+	}
+}
+
+func TestGatewayForwardsHTTPClientIdentity(t *testing.T) {
+	g := newTestGateway(t)
+
+	req := httptest.NewRequest(http.MethodGet, "/v1/images/42", nil)
+	req.RemoteAddr = "198.51.100.1:40000"
+	req.Header.Set("X-Client-Id", "someone-else")
+	rec := httptest.NewRecorder()
+	g.ServeHTTP(rec, req)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("GET = %d: %s", rec.Code, rec.Body)
+	}
+
+	var img UploadedImage
+	if err := protojson.Unmarshal(rec.Body.Bytes(), &img); err != nil {
+		t.Fatal(err)
+	}
+	if img.OwnerId != "198.51.100.1" {
+		t.Errorf("sunucunun gördüğü istemci = %q, want HTTP istemcisinin adresi", img.OwnerId)
+	}
+}
+
+func TestBindQuery(t *testing.T) {
+	req := &GetImageFeedRequest{}
+	r := httptest.NewRequest(http.MethodGet, "/v1/feed?filter.emotions=joy&filter.emotions=sorrow&ranking=FEED_RANKING_HOT", nil)
+	if err := bindQuery(req, r); err != nil {
+		t.Fatalf("bindQuery: %v", err)
+	}
+	if len(req.GetFilter().GetEmotions()) != 2 || req.Ranking != FeedRanking_FEED_RANKING_HOT {
+		t.Errorf("istek = %v", req)
+	}
+
+	for _, query := range []string{"unknown=1", "ranking=POPULAR", "filter=x"} {
+		r := httptest.NewRequest(http.MethodGet, "/v1/feed?"+query, nil)
+		if err := bindQuery(&GetImageFeedRequest{}, r); status.Code(err) != codes.InvalidArgument {
+			t.Errorf("bindQuery(%s) = %v, want InvalidArgument", query, err)
+		}
+	}
+}
+
+func TestGetPhotoRejectsMalformedID(t *testing.T) {
+	// Geçersiz ID'ler veritabanına ulaşmadan reddedilir.
+	for _, id := range []string{"", "abc", "1; DROP TABLE photos"} {
+		if _, err := getPhoto(context.Background(), id); status.Code(err) != codes.InvalidArgument {
+			t.Errorf("getPhoto(%q) = %v, want InvalidArgument", id, err)
+		}
+	}
+}
@@ -0,0 +1,197 @@
+package photo
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"math"
+	"strconv"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// maxSearchRadiusMeters, SearchImagesNear için kabul edilen en büyük yarıçaptır.
+const maxSearchRadiusMeters = 1000 * 1000
+
+// validCoordinate, enlem ve boylamın geçerli WGS84 aralığında olup olmadığını döndürür.
+func validCoordinate(lat, lng float64) bool {
+	return !math.IsNaN(lat) && !math.IsNaN(lng) && lat >= -90 && lat <= 90 && lng >= -180 && lng <= 180
+}
+
+// validateClientLocation, istemcinin gönderdiği konumun koordinatlarını doğrular. Vision API çağrısından önce
+// çalıştırılır; böylece geçersiz istekler analiz maliyeti oluşturmaz.
+func validateClientLocation(client *Location) error {
+	if client != nil && !validCoordinate(client.Latitude, client.Longitude) {
+		return status.Errorf(codes.InvalidArgument, "geçersiz konum: %f, %f", client.Latitude, client.Longitude)
+	}
+	return nil
+}
+
+// resolveLocation, istemcinin gönderdiği konumu kullanır; yoksa Vision API yer işaretini kullanır. İstemci
+// koordinatları sunucuda doğrulanamadığından, istemcinin bildirdiği kaynak ve ad yok sayılır ve konum CLIENT
+// olarak kaydedilir. İstemci konumu önceden validateClientLocation ile doğrulanmış olmalıdır. Hiçbiri yoksa
+// nil döndürür.
+func resolveLocation(client *Location, landmark *LandmarkResult) *Location {
+	if client != nil {
+		return &Location{
+			Latitude:  client.Latitude,
+			Longitude: client.Longitude,
+			Source:    LocationSource_LOCATION_SOURCE_CLIENT,
+		}
+	}
+	if landmark != nil && validCoordinate(landmark.Latitude, landmark.Longitude) {
+		return &Location{
+			Latitude:  landmark.Latitude,
+			Longitude: landmark.Longitude,
+			Source:    LocationSource_LOCATION_SOURCE_LANDMARK,
+			Name:      landmark.Name,
+		}
+	}
+	return nil
+}
+
+// syncLocationIndex, bir fotoğrafın konum dizinindeki kaydını görünürlüğüne göre ekler veya kaldırır.
+// Yalnızca kabul edilmiş ve konumu olan fotoğraflar coğrafi sorgularda döner.
+func syncLocationIndex(ctx context.Context, locations LocationRepository, img *UploadedImage) {
+	id, err := photoIDInt(img.Id)
+	if err != nil {
+		return
+	}
+
+	if img.Location != nil && img.ModerationStatus == ModerationStatus_MODERATION_ACCEPTED {
+		err = locations.Upsert(ctx, id, img.Location.Latitude, img.Location.Longitude)
+	} else {
+		err = locations.Remove(ctx, id)
+	}
+	if err != nil {
+		slog.ErrorContext(ctx, "Konum dizini güncellenemedi", "photo_id", img.Id, "error", err)
+	}
+}
+
+// SearchImagesNear, verilen noktaya yarıçap içindeki fotoğrafları yakından uzağa listeler.
+func (s *PhotoService) SearchImagesNear(ctx context.Context, req *SearchImagesNearRequest) (*GeoSearchResponse, error) {
+	if !validCoordinate(req.Latitude, req.Longitude) {
+		return nil, status.Error(codes.InvalidArgument, "geçersiz merkez koordinatı")
+	}
+	if req.RadiusMeters <= 0 || req.RadiusMeters > maxSearchRadiusMeters {
+		return nil, status.Errorf(codes.InvalidArgument, "yarıçap 0 ile %d metre arasında olmalıdır", maxSearchRadiusMeters)
+	}
+
+	pageSize := clampPageSize(req.PageSize)
+	filter := filterDigest("near", strconv.FormatFloat(req.Latitude, 'f', -1, 64),
+		strconv.FormatFloat(req.Longitude, 'f', -1, 64), strconv.FormatFloat(req.RadiusMeters, 'f', -1, 64))
+	page, err := resolvePage(req.PageToken, 0, pageSize, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	hits, err := s.locations.Near(ctx, NearQuery{
+		Latitude:     req.Latitude,
+		Longitude:    req.Longitude,
+		RadiusMeters: req.RadiusMeters,
+		Limit:        pageSize + 1,
+		Offset:       page.Offset,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Konum araması yapılamadı: %v", err)
+	}
+	return geoSearchResponse(ctx, hits, page, pageSize, true)
+}
+
+// SearchImagesInBox, enlem/boylam kutusu içindeki fotoğrafları yeniden eskiye listeler.
+func (s *PhotoService) SearchImagesInBox(ctx context.Context, req *SearchImagesInBoxRequest) (*GeoSearchResponse, error) {
+	if !validCoordinate(req.MinLatitude, req.MinLongitude) || !validCoordinate(req.MaxLatitude, req.MaxLongitude) {
+		return nil, status.Error(codes.InvalidArgument, "geçersiz kutu koordinatı")
+	}
+	if req.MinLatitude > req.MaxLatitude {
+		return nil, status.Error(codes.InvalidArgument, "min_latitude, max_latitude değerinden büyük olamaz")
+	}
+
+	pageSize := clampPageSize(req.PageSize)
+	filter := filterDigest("box", strconv.FormatFloat(req.MinLatitude, 'f', -1, 64), strconv.FormatFloat(req.MinLongitude, 'f', -1, 64),
+		strconv.FormatFloat(req.MaxLatitude, 'f', -1, 64), strconv.FormatFloat(req.MaxLongitude, 'f', -1, 64))
+	page, err := resolvePage(req.PageToken, 0, pageSize, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	hits, err := s.locations.InBox(ctx, BoxQuery{
+		MinLatitude:  req.MinLatitude,
+		MinLongitude: req.MinLongitude,
+		MaxLatitude:  req.MaxLatitude,
+		MaxLongitude: req.MaxLongitude,
+		Limit:        pageSize + 1,
+		Offset:       page.Offset,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Konum araması yapılamadı: %v", err)
+	}
+	return geoSearchResponse(ctx, hits, page, pageSize, false)
+}
+
+// geoSearchResponse, dizin sonuçlarını fotoğraf kayıtlarıyla birleştirerek yanıtı oluşturur.
+func geoSearchResponse(ctx context.Context, hits []GeoHit, page pageToken, pageSize int, withDistance bool) (*GeoSearchResponse, error) {
+	hasMore := len(hits) > pageSize
+	if hasMore {
+		hits = hits[:pageSize]
+	}
+
+	ids := make([]int64, 0, len(hits))
+	for _, hit := range hits {
+		ids = append(ids, hit.PhotoID)
+	}
+	images, err := GetPhotosByIDs(ctx, ids)
+	if err != nil {
+		return nil, fmt.Errorf("Veritabanından fotoğraflar alınamadı: %v", err)
+	}
+	if err := loadImageDetails(ctx, images); err != nil {
+		return nil, fmt.Errorf("Fotoğraf ayrıntıları alınamadı: %v", err)
+	}
+	images = hideForeignPersons(ctx, images)
+
+	byID := make(map[string]*UploadedImage, len(images))
+	for _, img := range images {
+		byID[img.Id] = img
+	}
+
+	response := &GeoSearchResponse{NextPageToken: nextPageToken(page, pageSize, hasMore)}
+	for _, hit := range hits {
+		img, ok := byID[strconv.FormatInt(hit.PhotoID, 10)]
+		if !ok {
+			// Dizin ile fotoğraf tablosu arasındaki kısa süreli tutarsızlıklarda kayıt atlanır.
+			continue
+		}
+		result := &GeoSearchResult{Image: img}
+		if withDistance {
+			result.DistanceMeters = hit.DistanceMeters
+		}
+		response.Results = append(response.Results, result)
+	}
+	return response, nil
+}
+
+// GetPhotosByIDs, verilen ID'lere sahip fotoğrafları sırasız olarak çeker.
+func GetPhotosByIDs(ctx context.Context, ids []int64) ([]*UploadedImage, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	rows, err := db.QueryContext(ctx, `SELECT `+photoColumns+` FROM photos WHERE id = ANY($1)`, ids)
+	if err != nil {
+		slog.ErrorContext(ctx, "Fotoğraflar alınamadı", "error", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	var images []*UploadedImage
+	for rows.Next() {
+		img, err := scanPhoto(rows)
+		if err != nil {
+			slog.ErrorContext(ctx, "Fotoğraf alınamadı", "error", err)
+			return nil, err
+		}
+		images = append(images, img)
+	}
+	return images, rows.Err()
+}
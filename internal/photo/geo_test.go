@@ -0,0 +1,69 @@
+package photo
+
+import (
+	"math"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestValidateClientLocation(t *testing.T) {
+	tests := []struct {
+		name     string
+		client   *Location
+		wantCode codes.Code
+	}{
+		{"konum yok", nil, codes.OK},
+		{"geçerli", &Location{Latitude: 41, Longitude: 29}, codes.OK},
+		{"enlem sınır dışı", &Location{Latitude: 91, Longitude: 29}, codes.InvalidArgument},
+		{"boylam sınır dışı", &Location{Latitude: 41, Longitude: -181}, codes.InvalidArgument},
+		{"NaN", &Location{Latitude: math.NaN(), Longitude: 29}, codes.InvalidArgument},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if code := status.Code(validateClientLocation(tt.client)); code != tt.wantCode {
+				t.Errorf("validateClientLocation = %s, want %s", code, tt.wantCode)
+			}
+		})
+	}
+}
+
+func TestResolveLocation(t *testing.T) {
+	landmark := &LandmarkResult{Name: "Galata Kulesi", Latitude: 41.0256, Longitude: 28.9741}
+
+	tests := []struct {
+		name     string
+		client   *Location
+		landmark *LandmarkResult
+		want     *Location
+	}{
+		{
+			name:     "istemci konumu CLIENT olarak kaydedilir",
+			client:   &Location{Latitude: 41, Longitude: 29, Source: LocationSource_LOCATION_SOURCE_LANDMARK, Name: "ev"},
+			landmark: landmark,
+			want:     &Location{Latitude: 41, Longitude: 29, Source: LocationSource_LOCATION_SOURCE_CLIENT},
+		},
+		{
+			name:     "yer işareti",
+			landmark: landmark,
+			want: &Location{Latitude: 41.0256, Longitude: 28.9741, Source: LocationSource_LOCATION_SOURCE_LANDMARK,
+				Name: "Galata Kulesi"},
+		},
+		{
+			name:     "geçersiz yer işareti yok sayılır",
+			landmark: &LandmarkResult{Latitude: math.NaN(), Longitude: 29},
+		},
+		{
+			name: "konum yok",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := resolveLocation(tt.client, tt.landmark); !proto.Equal(got, tt.want) {
+				t.Errorf("resolveLocation = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
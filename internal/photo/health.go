@@ -0,0 +1,105 @@
+package photo
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"myphotoapp/config"
+
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+const (
+	defaultHealthInterval = 10 * time.Second
+	defaultHealthTimeout  = 3 * time.Second
+)
+
+// Sağlık servisinde bağımlılıkların durumlarının yayımlandığı adlar. Boş ad sunucunun genel durumudur.
+const (
+	HealthPostgres = "postgres"
+	HealthKafka    = "kafka"
+	HealthVision   = "vision"
+)
+
+// HealthChecker, bağımlılıkları düzenli olarak kontrol eder ve sonuçları grpc.health.v1 servisinde yayımlar.
+// Vision API'ye ulaşılamadığında yüklemeler analiz kuyruğuna alındığından Vision durumu genel durumu etkilemez.
+type HealthChecker struct {
+	server   *health.Server
+	kafka    *KafkaProducer
+	vision   *VisionAPI
+	interval time.Duration
+	timeout  time.Duration
+}
+
+// NewHealthChecker, yapılandırmaya göre yeni bir HealthChecker oluşturur. İlk kontrol yapılana kadar bütün
+// durumlar NOT_SERVING'dir.
+func NewHealthChecker(cfg config.HealthConfig, kafka *KafkaProducer, vision *VisionAPI) *HealthChecker {
+	h := &HealthChecker{
+		server:   health.NewServer(),
+		kafka:    kafka,
+		vision:   vision,
+		interval: cfg.Interval,
+		timeout:  cfg.Timeout,
+	}
+	if h.interval <= 0 {
+		h.interval = defaultHealthInterval
+	}
+	if h.timeout <= 0 {
+		h.timeout = defaultHealthTimeout
+	}
+	for _, service := range []string{"", HealthPostgres, HealthKafka, HealthVision} {
+		h.server.SetServingStatus(service, healthpb.HealthCheckResponse_NOT_SERVING)
+	}
+	return h
+}
+
+// Server, gRPC sunucusuna kaydedilecek sağlık servisini döndürür.
+func (h *HealthChecker) Server() healthpb.HealthServer {
+	return h.server
+}
+
+// Run, bağlam iptal edilene kadar bağımlılıkları kontrol eder.
+func (h *HealthChecker) Run(ctx context.Context) {
+	every(ctx, h.interval, h.check)
+}
+
+// Shutdown, kapanış başladığında bütün durumları NOT_SERVING yapar ve sonraki güncellemeleri yok sayar.
+func (h *HealthChecker) Shutdown() {
+	h.server.Shutdown()
+}
+
+func (h *HealthChecker) check(ctx context.Context) {
+	ctx, cancel := context.WithTimeout(ctx, h.timeout)
+	defer cancel()
+
+	postgres := h.report(HealthPostgres, PingDB(ctx))
+	kafka := h.report(HealthKafka, h.kafka.CheckConnection(h.timeout))
+	h.report(HealthVision, h.vision.CheckAvailable())
+
+	overall := healthpb.HealthCheckResponse_NOT_SERVING
+	if postgres && kafka {
+		overall = healthpb.HealthCheckResponse_SERVING
+	}
+	h.server.SetServingStatus("", overall)
+}
+
+// report, bağımlılığın durumunu yayımlar ve sağlıklı olup olmadığını döndürür. Durum değiştiğinde günlüğe yazılır.
+func (h *HealthChecker) report(service string, err error) bool {
+	status := healthpb.HealthCheckResponse_SERVING
+	if err != nil {
+		status = healthpb.HealthCheckResponse_NOT_SERVING
+	}
+
+	resp, _ := h.server.Check(context.Background(), &healthpb.HealthCheckRequest{Service: service})
+	if resp.GetStatus() != status {
+		if err != nil {
+			slog.Warn("Sağlık kontrolü başarısız", "service", service, "error", err)
+		} else {
+			slog.Info("Sağlık kontrolü düzeldi", "service", service)
+		}
+	}
+	h.server.SetServingStatus(service, status)
+	return err == nil
+}
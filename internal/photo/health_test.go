@@ -0,0 +1,41 @@
+package photo
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"myphotoapp/config"
+
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+func TestHealthCheckerReport(t *testing.T) {
+	h := NewHealthChecker(config.HealthConfig{}, nil, nil)
+	statusOf := func(service string) healthpb.HealthCheckResponse_ServingStatus {
+		resp, err := h.Server().Check(context.Background(), &healthpb.HealthCheckRequest{Service: service})
+		if err != nil {
+			t.Fatalf("Check(%q): %v", service, err)
+		}
+		return resp.Status
+	}
+
+	// İlk kontrolden önce hiçbir şey hazır sayılmaz.
+	if got := statusOf(""); got != healthpb.HealthCheckResponse_NOT_SERVING {
+		t.Errorf("başlangıç durumu = %v, want NOT_SERVING", got)
+	}
+
+	if !h.report(HealthPostgres, nil) || statusOf(HealthPostgres) != healthpb.HealthCheckResponse_SERVING {
+		t.Error("sağlıklı bağımlılık SERVING olmalı")
+	}
+	if h.report(HealthKafka, errors.New("bağlantı yok")) || statusOf(HealthKafka) != healthpb.HealthCheckResponse_NOT_SERVING {
+		t.Error("başarısız bağımlılık NOT_SERVING olmalı")
+	}
+
+	// Kapanıştan sonra durumlar NOT_SERVING kalır.
+	h.Shutdown()
+	h.report(HealthPostgres, nil)
+	if got := statusOf(HealthPostgres); got != healthpb.HealthCheckResponse_NOT_SERVING {
+		t.Errorf("kapanış sonrası durum = %v, want NOT_SERVING", got)
+	}
+}
@@ -0,0 +1,86 @@
+package photo
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"crypto/x509"
+	"encoding/hex"
+	"fmt"
+	"net"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	clientIDHeader     = "x-client-id"
+	gatewayTokenHeader = "x-gateway-token"
+)
+
+// gatewayToken, süreç içindeki REST geçidinin gRPC isteklerine eklediği gizli değerdir. x-client-id üst
+// verisine yalnızca bu değeri taşıyan isteklerde güvenilir; değer süreç dışına çıkmaz ve her başlangıçta yenilenir.
+var gatewayToken = newGatewayToken()
+
+func newGatewayToken() string {
+	var b [32]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		panic(fmt.Sprintf("geçit belirteci üretilemedi: %v", err))
+	}
+	return hex.EncodeToString(b[:])
+}
+
+// fromGateway, isteğin süreç içindeki REST geçidinden geldiğini doğrular.
+func fromGateway(md metadata.MD) bool {
+	tokens := md.Get(gatewayTokenHeader)
+	return len(tokens) == 1 && subtle.ConstantTimeCompare([]byte(tokens[0]), []byte(gatewayToken)) == 1
+}
+
+// verifiedPeerCertificate, bağlantı karşılıklı TLS ile kurulduysa istemcinin doğrulanmış sertifikasını döndürür.
+func verifiedPeerCertificate(ctx context.Context) *x509.Certificate {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return nil
+	}
+	info, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok || len(info.State.VerifiedChains) == 0 || len(info.State.VerifiedChains[0]) == 0 {
+		return nil
+	}
+	return info.State.VerifiedChains[0][0]
+}
+
+// clientIdentity, isteği yapan istemciyi sırasıyla doğrulanmış istemci sertifikasından, REST geçidinin
+// bildirdiği x-client-id üst verisinden ya da bağlantı adresinden belirler. İstemcinin istekte gönderdiği kimlik
+// alanlarına ve geçit dışından gelen x-client-id'ye güvenilmez. Sunucu içi çağrılarda boş döner.
+func clientIdentity(ctx context.Context) string {
+	if cert := verifiedPeerCertificate(ctx); cert != nil && cert.Subject.CommonName != "" {
+		return "cert:" + cert.Subject.CommonName
+	}
+	if md, ok := metadata.FromIncomingContext(ctx); ok && fromGateway(md) {
+		if ids := md.Get(clientIDHeader); len(ids) > 0 && ids[0] != "" {
+			return ids[0]
+		}
+	}
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		if host, _, err := net.SplitHostPort(p.Addr.String()); err == nil {
+			return host
+		}
+		return p.Addr.String()
+	}
+	return ""
+}
+
+// authorizeOwner, isteği yapan istemcinin kaydın sahibi olduğunu doğrular. Sahibi bilinmeyen kayıtlar
+// (sahiplik eklenmeden önce yüklenenler ya da sunucu içinden eklenenler) değiştirilemez.
+func authorizeOwner(ctx context.Context, ownerID string) error {
+	if ownerID == "" {
+		return status.Error(codes.PermissionDenied, "sahibi olmayan kayıt değiştirilemez")
+	}
+	if clientIdentity(ctx) != ownerID {
+		return status.Error(codes.PermissionDenied, "kaydı yalnızca sahibi değiştirebilir")
+	}
+	return nil
+}
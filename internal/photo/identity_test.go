@@ -0,0 +1,61 @@
+package photo
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// peerContext, verilen adresten bağlanmış bir istemcinin RPC bağlamını üretir.
+func peerContext(ip string) context.Context {
+	return peer.NewContext(context.Background(), &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP(ip), Port: 50000}})
+}
+
+func TestClientIdentity(t *testing.T) {
+	if got := clientIdentity(peerContext("203.0.113.7")); got != "203.0.113.7" {
+		t.Errorf("clientIdentity = %q, want bağlantı adresi", got)
+	}
+	// İstemcinin gönderdiği üst veri kimliği değiştiremez.
+	ctx := metadata.NewIncomingContext(peerContext("203.0.113.7"), metadata.Pairs("x-client-id", "someone-else"))
+	if got := clientIdentity(ctx); got != "203.0.113.7" {
+		t.Errorf("clientIdentity = %q, want bağlantı adresi", got)
+	}
+	// REST geçidinin bildirdiği istemci yalnızca geçit belirteciyle kabul edilir.
+	ctx = metadata.NewIncomingContext(peerContext("127.0.0.1"), metadata.Pairs(clientIDHeader, "198.51.100.1", gatewayTokenHeader, "guess"))
+	if got := clientIdentity(ctx); got != "127.0.0.1" {
+		t.Errorf("yanlış geçit belirteciyle clientIdentity = %q, want bağlantı adresi", got)
+	}
+	ctx = metadata.NewIncomingContext(peerContext("127.0.0.1"), metadata.Pairs(clientIDHeader, "198.51.100.1", gatewayTokenHeader, gatewayToken))
+	if got := clientIdentity(ctx); got != "198.51.100.1" {
+		t.Errorf("geçitten gelen istekte clientIdentity = %q, want geçidin bildirdiği istemci", got)
+	}
+	if got := clientIdentity(context.Background()); got != "" {
+		t.Errorf("sunucu içi çağrıda clientIdentity = %q, want boş", got)
+	}
+}
+
+func TestAuthorizeOwner(t *testing.T) {
+	tests := []struct {
+		name     string
+		ctx      context.Context
+		owner    string
+		wantCode codes.Code
+	}{
+		{"sahibi", peerContext("203.0.113.7"), "203.0.113.7", codes.OK},
+		{"başkasının kaydı", peerContext("198.51.100.1"), "203.0.113.7", codes.PermissionDenied},
+		{"sahibi olmayan kayıt", peerContext("198.51.100.1"), "", codes.PermissionDenied},
+		{"sunucu içi çağrı", context.Background(), "203.0.113.7", codes.PermissionDenied},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if code := status.Code(authorizeOwner(tt.ctx, tt.owner)); code != tt.wantCode {
+				t.Errorf("authorizeOwner = %s, want %s", code, tt.wantCode)
+			}
+		})
+	}
+}
@@ -0,0 +1,48 @@
+package photo
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"image"
+	_ "image/gif"
+	_ "image/jpeg"
+	_ "image/png"
+	"net/url"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// checkImageURL, görüntü URL'sinin HTTP ya da HTTPS olduğunu doğrular.
+func checkImageURL(u *url.URL) error {
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return fmt.Errorf("desteklenmeyen URL şeması: %q", u.Scheme)
+	}
+	if u.Hostname() == "" {
+		return errors.New("URL'de sunucu adı yok")
+	}
+	return nil
+}
+
+// validateImageURL, istemcinin gönderdiği görüntü URL'sini Vision API çağrısından önce doğrular.
+func validateImageURL(imageURI string) error {
+	u, err := url.Parse(imageURI)
+	if err == nil {
+		err = checkImageURL(u)
+	}
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "geçersiz görüntü URL'si: %v", err)
+	}
+	return nil
+}
+
+// imageSize, indirilmiş görüntünün başlığından piksel genişliğini ve yüksekliğini döndürür. JPEG, PNG ve GIF
+// desteklenir.
+func imageSize(content []byte) (int, int, error) {
+	cfg, _, err := image.DecodeConfig(bytes.NewReader(content))
+	if err != nil {
+		return 0, 0, fmt.Errorf("görüntü boyutları okunamadı: %v", err)
+	}
+	return cfg.Width, cfg.Height, nil
+}
@@ -0,0 +1,37 @@
+package photo
+
+import (
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestValidateImageURL(t *testing.T) {
+	tests := []struct {
+		url      string
+		wantCode codes.Code
+	}{
+		{"https://example.com/a.jpg", codes.OK},
+		{"http://example.com/a.jpg", codes.OK},
+		{"gs://bucket/a.jpg", codes.InvalidArgument},
+		{"file:///etc/passwd", codes.InvalidArgument},
+		{"https:///a.jpg", codes.InvalidArgument},
+		{"://", codes.InvalidArgument},
+	}
+	for _, tt := range tests {
+		if code := status.Code(validateImageURL(tt.url)); code != tt.wantCode {
+			t.Errorf("validateImageURL(%q) = %s, want %s", tt.url, code, tt.wantCode)
+		}
+	}
+}
+
+func TestImageSize(t *testing.T) {
+	w, h, err := imageSize(testPNG)
+	if err != nil || w != 1 || h != 1 {
+		t.Errorf("imageSize = %d, %d, %v, want 1, 1", w, h, err)
+	}
+	if _, _, err := imageSize([]byte("görüntü değil")); err == nil {
+		t.Error("görüntü olmayan içerik için hata beklenirdi")
+	}
+}
@@ -1,61 +1,203 @@
 package photo
 
 import (
+	"context"
 	"fmt"
-	"log"
+	"log/slog"
 	"myphotoapp/config"
+	"time"
 
 	"github.com/confluentinc/confluent-kafka-go/kafka"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	semconv "go.opentelemetry.io/otel/semconv/v1.24.0"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc/status"
 )
 
-var kafkaBrokers string
+const (
+	defaultKafkaDeliveryTimeout = 30 * time.Second
+	defaultKafkaFlushTimeout    = 10 * time.Second
+)
+
+// Teslim modları. Asenkron modda ProduceMessage mesaj kuyruğa alınınca döner ve teslim sonucu arka planda
+// işlenir; senkron modda teslim raporu gelene kadar bekler ve teslim hatasını döndürür.
+const (
+	kafkaDeliveryAsync = "async"
+	kafkaDeliverySync  = "sync"
+)
+
+// KafkaProducer, Kafka'ya mesaj gönderme işlemlerini yöneten bir yapıdır.
+type KafkaProducer struct {
+	producer     *kafka.Producer
+	sync         bool
+	flushTimeout time.Duration
+	done         chan struct{} // Teslim raporlarını okuyan döngü bitince kapanır
+}
 
-func init() {
-	initKafkaConfig()
+// delivery, teslim raporunda mesajın kimden geldiğini bilmek için mesajın Opaque alanında taşınır.
+type delivery struct {
+	ctx    context.Context // Günlüklerde istek kimliğini ve izi taşır; iptali dikkate alınmaz
+	span   trace.Span      // Mesaj teslim edilince ya da teslim başarısız olunca kapanır
+	result chan error      // Senkron modda teslim sonucunu bekleyen çağrıya iletir
 }
 
-func initKafkaConfig() {
-	configPath := "config/config.yaml"
-	config, err := config.LoadConfig(configPath)
+// NewKafkaProducer, yapılandırmadaki aracılara bağlanan yeni bir KafkaProducer örneği oluşturur.
+func NewKafkaProducer(cfg config.KafkaConfig) (*KafkaProducer, error) {
+	kp := &KafkaProducer{flushTimeout: cfg.FlushTimeout, done: make(chan struct{})}
+	if kp.flushTimeout <= 0 {
+		kp.flushTimeout = defaultKafkaFlushTimeout
+	}
+	switch cfg.Delivery {
+	case "", kafkaDeliveryAsync:
+	case kafkaDeliverySync:
+		kp.sync = true
+	default:
+		return nil, fmt.Errorf("bilinmeyen Kafka teslim modu: %q", cfg.Delivery)
+	}
+	deliveryTimeout := cfg.DeliveryTimeout
+	if deliveryTimeout <= 0 {
+		deliveryTimeout = defaultKafkaDeliveryTimeout
+	}
+
+	p, err := kafka.NewProducer(&kafka.ConfigMap{
+		"bootstrap.servers": cfg.Broker,
+		// Teslim raporu en geç bu sürede gelir; senkron modda bekleme süresini de sınırlar.
+		"message.timeout.ms": int(deliveryTimeout.Milliseconds()),
+	})
 	if err != nil {
-		log.Fatalf("Konfigürasyon dosyası okunamadı: %v", err)
+		return nil, fmt.Errorf("Kafka üretici oluşturulamadı: %v", err)
 	}
+	kp.producer = p
+
+	slog.Info("Kafka üretici başlatıldı", "delivery", cfg.Delivery)
 
-	kafkaBrokers = config.Kafka.Broker
+	go kp.handleEvents()
+	return kp, nil
 }
 
-// KafkaProducer, Kafka'ya mesaj gönderme işlemlerini yöneten bir yapıdır.
-type KafkaProducer struct {
-	producer *kafka.Producer
+// handleEvents, üreticinin teslim raporlarını ve hatalarını okur. Üretici kapanınca döner.
+func (kp *KafkaProducer) handleEvents() {
+	defer close(kp.done)
+	for e := range kp.producer.Events() {
+		switch ev := e.(type) {
+		case *kafka.Message:
+			kp.reportDelivery(ev)
+		case kafka.Error:
+			slog.Error("Kafka üretici hatası", "error", ev, "code", ev.Code().String())
+		}
+	}
 }
 
-// NewKafkaProducer, yeni bir KafkaProducer örneği oluşturur.
-func NewKafkaProducer() (*KafkaProducer, error) {
-	p, err := kafka.NewProducer(&kafka.ConfigMap{"bootstrap.servers": kafkaBrokers})
-	if err != nil {
-		return nil, fmt.Errorf("Kafka üretici oluşturulamadı: %v", err)
+// reportDelivery, teslim sonucunu ölçümlere yazar, başarısız teslimleri günlüğe yazar, mesajın span'ini kapatır
+// ve senkron modda sonucu bekleyen çağrıya iletir.
+func (kp *KafkaProducer) reportDelivery(msg *kafka.Message) {
+	topic := ""
+	if msg.TopicPartition.Topic != nil {
+		topic = *msg.TopicPartition.Topic
+	}
+	d, _ := msg.Opaque.(*delivery)
+	ctx := context.Background()
+	if d != nil {
+		ctx = d.ctx
 	}
 
-	log.Printf("Kafka üretici başlatıldı")
+	err := msg.TopicPartition.Error
+	if err != nil {
+		kafkaDeliveries.WithLabelValues(topic, "failure").Inc()
+		slog.ErrorContext(ctx, "Kafka mesajı teslim edilemedi", "topic", topic, "error", err)
+		err = fmt.Errorf("Mesaj teslim edilemedi: %v", err)
+	} else {
+		kafkaDeliveries.WithLabelValues(topic, "success").Inc()
+		slog.DebugContext(ctx, "Kafka mesajı teslim edildi", "topic", topic,
+			"partition", msg.TopicPartition.Partition, "offset", msg.TopicPartition.Offset.String())
+	}
 
-	return &KafkaProducer{producer: p}, nil
+	if d == nil {
+		return
+	}
+	if err == nil {
+		d.span.SetAttributes(attribute.Int("messaging.kafka.destination.partition", int(msg.TopicPartition.Partition)))
+	}
+	endSpan(d.span, err)
+	if d.result != nil {
+		d.result <- err
+	}
 }
 
-// ProduceMessage, Kafka'ya mesaj gönderir.
-func (kp *KafkaProducer) ProduceMessage(topic string, message string) error {
-	err := kp.producer.Produce(&kafka.Message{
+// ProduceMessage, Kafka'ya verilen anahtar ve başlıklarla mesaj gönderir. Bağlamdaki iz ve istek kimliği,
+// tüketicinin devam ettirebilmesi için mesaj başlıklarına eklenir. Senkron modda teslim raporu gelene ya da
+// bağlam iptal edilene kadar bekler.
+func (kp *KafkaProducer) ProduceMessage(ctx context.Context, topic string, key, value []byte, headers []kafka.Header) error {
+	ctx, span := tracer.Start(ctx, topic+" publish",
+		trace.WithSpanKind(trace.SpanKindProducer),
+		trace.WithAttributes(semconv.MessagingSystemKafka, semconv.MessagingDestinationName(topic),
+			semconv.MessagingOperationPublish))
+
+	d := &delivery{ctx: context.WithoutCancel(ctx), span: span}
+	if kp.sync {
+		d.result = make(chan error, 1)
+	}
+	msg := &kafka.Message{
 		TopicPartition: kafka.TopicPartition{Topic: &topic, Partition: kafka.PartitionAny},
-		Value:          []byte(message),
-	}, nil)
+		Key:            key,
+		Value:          value,
+		Headers:        append([]kafka.Header(nil), headers...),
+		Opaque:         d,
+	}
+	otel.GetTextMapPropagator().Inject(ctx, kafkaHeaderCarrier{headers: &msg.Headers})
+	if id := RequestIDFromContext(ctx); id != "" {
+		msg.Headers = append(msg.Headers, kafka.Header{Key: requestIDHeader, Value: []byte(id)})
+	}
 
-	if err != nil {
-		return fmt.Errorf("Mesaj gönderilemedi: %v", err)
+	if err := kp.producer.Produce(msg, nil); err != nil {
+		kafkaDeliveries.WithLabelValues(topic, "failure").Inc()
+		slog.ErrorContext(ctx, "Kafka mesajı kuyruğa alınamadı", "topic", topic, "error", err)
+		err = fmt.Errorf("Mesaj gönderilemedi: %v", err)
+		endSpan(span, err)
+		return err
+	}
+	if !kp.sync {
+		return nil
 	}
 
+	select {
+	case err := <-d.result:
+		return err
+	case <-ctx.Done():
+		// Mesaj kuyrukta kalır; sonucu teslim raporu geldiğinde günlüğe yazılır.
+		return status.FromContextError(ctx.Err()).Err()
+	}
+}
+
+// CheckConnection, aracılardan küme üst verisini isteyerek Kafka bağlantısının çalıştığını doğrular.
+func (kp *KafkaProducer) CheckConnection(timeout time.Duration) error {
+	if _, err := kp.producer.GetMetadata(nil, false, int(timeout.Milliseconds())); err != nil {
+		return fmt.Errorf("Kafka üst verisi alınamadı: %v", err)
+	}
 	return nil
 }
 
-// Close, Kafka üreticisini kapatır.
-func (kp *KafkaProducer) Close() {
+// Flush, kuyruktaki mesajların gönderilmesini en fazla timeout kadar bekler ve gönderilemeyen mesaj sayısını
+// döndürür.
+func (kp *KafkaProducer) Flush(timeout time.Duration) int {
+	return kp.producer.Flush(int(timeout.Milliseconds()))
+}
+
+// Len, üreticide henüz teslim edilmemiş mesaj sayısını döndürür.
+func (kp *KafkaProducer) Len() int {
+	return kp.producer.Len()
+}
+
+// Close, kuyruktaki mesajların teslimini en fazla yapılandırılan süre kadar bekler, teslim edilemeyen mesaj
+// sayısını günlüğe yazar ve Kafka üreticisini kapatır. Teslim edilemeyen mesaj sayısını döndürür.
+func (kp *KafkaProducer) Close() int {
+	remaining := kp.Flush(kp.flushTimeout)
+	if remaining > 0 {
+		kafkaUndelivered.Add(float64(remaining))
+		slog.Error("Kapanışta Kafka mesajları teslim edilemedi", "count", remaining, "timeout", kp.flushTimeout)
+	}
 	kp.producer.Close()
+	<-kp.done
+	return remaining
 }
@@ -0,0 +1,54 @@
+package photo
+
+import (
+	"context"
+	"testing"
+
+	"myphotoapp/config"
+
+	"github.com/confluentinc/confluent-kafka-go/kafka"
+	otelcodes "go.opentelemetry.io/otel/codes"
+)
+
+func TestNewKafkaProducerRejectsUnknownDelivery(t *testing.T) {
+	if _, err := NewKafkaProducer(config.KafkaConfig{Broker: "localhost:9092", Delivery: "bazen"}); err == nil {
+		t.Error("bilinmeyen teslim modu kabul edildi")
+	}
+}
+
+func TestReportDelivery(t *testing.T) {
+	recorder := recordSpans(t)
+	kp := &KafkaProducer{sync: true}
+	topic := "image-upload-topic"
+
+	tests := []struct {
+		name    string
+		err     error
+		wantErr bool
+	}{
+		{"teslim edildi", nil, false},
+		{"teslim edilemedi", kafka.NewError(kafka.ErrMsgTimedOut, "zaman aşımı", false), true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, span := tracer.Start(context.Background(), topic+" publish")
+			d := &delivery{ctx: context.Background(), span: span, result: make(chan error, 1)}
+			kp.reportDelivery(&kafka.Message{
+				TopicPartition: kafka.TopicPartition{Topic: &topic, Error: tt.err},
+				Opaque:         d,
+			})
+
+			if err := <-d.result; (err != nil) != tt.wantErr {
+				t.Errorf("teslim sonucu = %v, want hata %v", err, tt.wantErr)
+			}
+			spans := recorder.Ended()
+			last := spans[len(spans)-1]
+			if got := last.Status().Code == otelcodes.Error; got != tt.wantErr {
+				t.Errorf("span durumu = %v, want hata %v", last.Status(), tt.wantErr)
+			}
+		})
+	}
+
+	// Bu paketle gönderilmemiş mesajların raporu yalnızca sayılır.
+	kp.reportDelivery(&kafka.Message{TopicPartition: kafka.TopicPartition{Topic: &topic}})
+}
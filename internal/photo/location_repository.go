@@ -0,0 +1,349 @@
+package photo
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log/slog"
+	"math"
+	"sort"
+	"strconv"
+	"sync"
+)
+
+// earthRadiusMeters, mesafe hesaplarında kullanılan ortalama dünya yarıçapıdır.
+const earthRadiusMeters = 6371008.8
+
+// GeoHit, coğrafi bir sorguda eşleşen fotoğrafı ve merkeze uzaklığını tutar.
+type GeoHit struct {
+	PhotoID        int64
+	Latitude       float64
+	Longitude      float64
+	DistanceMeters float64 // Yalnızca Near sorgularında doldurulur
+}
+
+// NearQuery, bir merkez etrafındaki yarıçap sorgusudur.
+type NearQuery struct {
+	Latitude     float64
+	Longitude    float64
+	RadiusMeters float64
+	Limit        int
+	Offset       int
+}
+
+// BoxQuery, bir enlem/boylam kutusu sorgusudur. MinLongitude > MaxLongitude ise kutu 180. meridyeni geçer.
+type BoxQuery struct {
+	MinLatitude  float64
+	MinLongitude float64
+	MaxLatitude  float64
+	MaxLongitude float64
+	Limit        int
+	Offset       int
+}
+
+// LocationRepository, akışta görünen fotoğrafların konumlarını dizinler ve coğrafi sorguları yanıtlar.
+// Uygulamalar aynı sıralamayı kullanmalıdır: Near için mesafeye, eşitlikte ID'ye göre artan;
+// InBox için ID'ye göre azalan (yeniden eskiye).
+type LocationRepository interface {
+	Upsert(ctx context.Context, photoID int64, latitude, longitude float64) error
+	Remove(ctx context.Context, photoID int64) error
+	Near(ctx context.Context, q NearQuery) ([]GeoHit, error)
+	InBox(ctx context.Context, q BoxQuery) ([]GeoHit, error)
+}
+
+// NewLocationRepository, yapılandırmadaki dizin türüne göre bir LocationRepository oluşturur.
+// "memory" seçilirse dizin başlangıçta veritabanındaki konumlarla doldurulur.
+func NewLocationRepository(ctx context.Context, kind string) (LocationRepository, error) {
+	switch kind {
+	case "", "postgres":
+		return NewPostgresLocationRepository(db), nil
+	case "memory":
+		repo := NewMemoryLocationRepository()
+		if err := loadLocationIndex(ctx, repo); err != nil {
+			return nil, err
+		}
+		return repo, nil
+	}
+	return nil, fmt.Errorf("bilinmeyen konum dizini türü: %q", kind)
+}
+
+// loadLocationIndex, akışta görünen ve konumu olan fotoğrafları verilen dizine ekler.
+func loadLocationIndex(ctx context.Context, repo LocationRepository) error {
+	rows, err := db.QueryContext(ctx, `SELECT photo_id, latitude, longitude FROM photo_locations`)
+	if err != nil {
+		slog.ErrorContext(ctx, "Konum dizini yüklenemedi", "error", err)
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var id int64
+		var lat, lng float64
+		if err := rows.Scan(&id, &lat, &lng); err != nil {
+			return err
+		}
+		if err := repo.Upsert(ctx, id, lat, lng); err != nil {
+			return err
+		}
+	}
+	return rows.Err()
+}
+
+// haversineMeters, iki nokta arasındaki büyük daire mesafesini metre cinsinden hesaplar.
+// Postgres uygulamasındaki SQL ifadesiyle aynı formülü kullanır.
+func haversineMeters(lat1, lng1, lat2, lng2 float64) float64 {
+	dLat := (lat2 - lat1) * math.Pi / 180
+	dLng := (lng2 - lng1) * math.Pi / 180
+	a := math.Pow(math.Sin(dLat/2), 2) +
+		math.Cos(lat1*math.Pi/180)*math.Cos(lat2*math.Pi/180)*math.Pow(math.Sin(dLng/2), 2)
+	return 2 * earthRadiusMeters * math.Asin(math.Sqrt(a))
+}
+
+// boundingBox, bir yarıçap sorgusunu kapsayan en küçük enlem/boylam kutusunu döndürür.
+// Kutu yalnızca ön eleme içindir; kesin sonuç haversine mesafesiyle belirlenir.
+func (q NearQuery) boundingBox() BoxQuery {
+	dLat := q.RadiusMeters / earthRadiusMeters * 180 / math.Pi
+	box := BoxQuery{
+		MinLatitude:  math.Max(q.Latitude-dLat, -90),
+		MaxLatitude:  math.Min(q.Latitude+dLat, 90),
+		MinLongitude: -180,
+		MaxLongitude: 180,
+	}
+
+	// Kutu bir kutbu içeriyorsa veya yarıçap çeyrek daireyi aşıyorsa tüm boylamlar taranır.
+	angular := q.RadiusMeters / earthRadiusMeters
+	if box.MinLatitude > -90 && box.MaxLatitude < 90 && angular < math.Pi/2 {
+		ratio := math.Sin(angular) / math.Cos(q.Latitude*math.Pi/180)
+		if ratio < 1 {
+			dLng := math.Asin(ratio) * 180 / math.Pi
+			box.MinLongitude = normalizeLongitude(q.Longitude - dLng)
+			box.MaxLongitude = normalizeLongitude(q.Longitude + dLng)
+		}
+	}
+	return box
+}
+
+// normalizeLongitude, boylamı [-180, 180] aralığına getirir.
+func normalizeLongitude(lng float64) float64 {
+	for lng < -180 {
+		lng += 360
+	}
+	for lng > 180 {
+		lng -= 360
+	}
+	return lng
+}
+
+// containsLongitude, boylamın kutunun boylam aralığında olup olmadığını, 180. meridyeni geçen kutuları da
+// hesaba katarak döndürür.
+func (q BoxQuery) containsLongitude(lng float64) bool {
+	if q.MinLongitude <= q.MaxLongitude {
+		return lng >= q.MinLongitude && lng <= q.MaxLongitude
+	}
+	return lng >= q.MinLongitude || lng <= q.MaxLongitude
+}
+
+// PostgresLocationRepository, konumları photo_locations tablosunda tutar. (latitude, longitude) dizini
+// kutu ön elemesini, haversine ifadesi kesin mesafe filtresini sağlar.
+type PostgresLocationRepository struct {
+	db *sql.DB
+}
+
+// NewPostgresLocationRepository, yeni bir PostgresLocationRepository örneği oluşturur.
+func NewPostgresLocationRepository(db *sql.DB) *PostgresLocationRepository {
+	return &PostgresLocationRepository{db: db}
+}
+
+// haversineSQL, haversineMeters ile aynı formülün SQL karşılığıdır. $1 enlem, $2 boylamdır.
+const haversineSQL = `2 * 6371008.8 * asin(sqrt(power(sin(radians(latitude - $1) / 2), 2) +
+        cos(radians($1)) * cos(radians(latitude)) * power(sin(radians(longitude - $2) / 2), 2)))`
+
+// Upsert, bir fotoğrafın konumunu dizine ekler veya günceller.
+func (r *PostgresLocationRepository) Upsert(ctx context.Context, photoID int64, latitude, longitude float64) error {
+	_, err := r.db.ExecContext(ctx, `INSERT INTO photo_locations (photo_id, latitude, longitude) VALUES ($1, $2, $3)
+                                     ON CONFLICT (photo_id) DO UPDATE SET latitude = EXCLUDED.latitude, longitude = EXCLUDED.longitude`,
+		photoID, latitude, longitude)
+	return err
+}
+
+// Remove, bir fotoğrafı dizinden çıkarır.
+func (r *PostgresLocationRepository) Remove(ctx context.Context, photoID int64) error {
+	_, err := r.db.ExecContext(ctx, `DELETE FROM photo_locations WHERE photo_id = $1`, photoID)
+	return err
+}
+
+// Near, merkeze verilen yarıçap içindeki fotoğrafları mesafeye göre sıralı döndürür.
+func (r *PostgresLocationRepository) Near(ctx context.Context, q NearQuery) ([]GeoHit, error) {
+	box := q.boundingBox()
+	rows, err := r.db.QueryContext(ctx, `SELECT photo_id, latitude, longitude, distance FROM (
+            SELECT photo_id, latitude, longitude, `+haversineSQL+` AS distance
+            FROM photo_locations
+            WHERE latitude BETWEEN $3 AND $4
+              AND (CASE WHEN $5::float8 <= $6::float8 THEN longitude BETWEEN $5 AND $6
+                        ELSE longitude >= $5 OR longitude <= $6 END)
+        ) d
+        WHERE distance <= $7
+        ORDER BY distance, photo_id
+        LIMIT $8 OFFSET $9`,
+		q.Latitude, q.Longitude, box.MinLatitude, box.MaxLatitude, box.MinLongitude, box.MaxLongitude,
+		q.RadiusMeters, q.Limit, q.Offset)
+	if err != nil {
+		slog.ErrorContext(ctx, "Konum sorgusu yapılamadı", "error", err)
+		return nil, err
+	}
+	return scanGeoHits(rows, true)
+}
+
+// InBox, kutu içindeki fotoğrafları yeniden eskiye sıralı döndürür.
+func (r *PostgresLocationRepository) InBox(ctx context.Context, q BoxQuery) ([]GeoHit, error) {
+	rows, err := r.db.QueryContext(ctx, `SELECT photo_id, latitude, longitude FROM photo_locations
+        WHERE latitude BETWEEN $1 AND $2
+          AND (CASE WHEN $3::float8 <= $4::float8 THEN longitude BETWEEN $3 AND $4
+                    ELSE longitude >= $3 OR longitude <= $4 END)
+        ORDER BY photo_id DESC
+        LIMIT $5 OFFSET $6`,
+		q.MinLatitude, q.MaxLatitude, q.MinLongitude, q.MaxLongitude, q.Limit, q.Offset)
+	if err != nil {
+		slog.ErrorContext(ctx, "Konum sorgusu yapılamadı", "error", err)
+		return nil, err
+	}
+	return scanGeoHits(rows, false)
+}
+
+func scanGeoHits(rows *sql.Rows, withDistance bool) ([]GeoHit, error) {
+	defer rows.Close()
+
+	var hits []GeoHit
+	for rows.Next() {
+		var hit GeoHit
+		dest := []any{&hit.PhotoID, &hit.Latitude, &hit.Longitude}
+		if withDistance {
+			dest = append(dest, &hit.DistanceMeters)
+		}
+		if err := rows.Scan(dest...); err != nil {
+			return nil, err
+		}
+		hits = append(hits, hit)
+	}
+	return hits, rows.Err()
+}
+
+// MemoryLocationRepository, konumları enleme göre sıralı bir dilimde tutar. Enlem aralığı ikili arama ile
+// bulunur, boylam ve mesafe filtreleri Postgres uygulamasıyla aynı kurallarla uygulanır.
+type MemoryLocationRepository struct {
+	mu      sync.RWMutex
+	entries []GeoHit // Enleme, eşitlikte ID'ye göre sıralı
+	byID    map[int64]GeoHit
+}
+
+// NewMemoryLocationRepository, boş bir MemoryLocationRepository örneği oluşturur.
+func NewMemoryLocationRepository() *MemoryLocationRepository {
+	return &MemoryLocationRepository{byID: make(map[int64]GeoHit)}
+}
+
+// Upsert, bir fotoğrafın konumunu dizine ekler veya günceller.
+func (r *MemoryLocationRepository) Upsert(ctx context.Context, photoID int64, latitude, longitude float64) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.removeLocked(photoID)
+	hit := GeoHit{PhotoID: photoID, Latitude: latitude, Longitude: longitude}
+	i := sort.Search(len(r.entries), func(i int) bool { return !entryLess(r.entries[i], hit) })
+	r.entries = append(r.entries, GeoHit{})
+	copy(r.entries[i+1:], r.entries[i:])
+	r.entries[i] = hit
+	r.byID[photoID] = hit
+	return nil
+}
+
+// Remove, bir fotoğrafı dizinden çıkarır.
+func (r *MemoryLocationRepository) Remove(ctx context.Context, photoID int64) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.removeLocked(photoID)
+	return nil
+}
+
+func (r *MemoryLocationRepository) removeLocked(photoID int64) {
+	old, ok := r.byID[photoID]
+	if !ok {
+		return
+	}
+	i := sort.Search(len(r.entries), func(i int) bool { return !entryLess(r.entries[i], old) })
+	if i < len(r.entries) && r.entries[i].PhotoID == photoID {
+		r.entries = append(r.entries[:i], r.entries[i+1:]...)
+	}
+	delete(r.byID, photoID)
+}
+
+func entryLess(a, b GeoHit) bool {
+	if a.Latitude != b.Latitude {
+		return a.Latitude < b.Latitude
+	}
+	return a.PhotoID < b.PhotoID
+}
+
+// inBoxLocked, kutu içindeki kayıtları sırasız döndürür.
+func (r *MemoryLocationRepository) inBoxLocked(box BoxQuery) []GeoHit {
+	start := sort.Search(len(r.entries), func(i int) bool { return r.entries[i].Latitude >= box.MinLatitude })
+
+	var hits []GeoHit
+	for _, entry := range r.entries[start:] {
+		if entry.Latitude > box.MaxLatitude {
+			break
+		}
+		if box.containsLongitude(entry.Longitude) {
+			hits = append(hits, entry)
+		}
+	}
+	return hits
+}
+
+// Near, merkeze verilen yarıçap içindeki fotoğrafları mesafeye göre sıralı döndürür.
+func (r *MemoryLocationRepository) Near(ctx context.Context, q NearQuery) ([]GeoHit, error) {
+	r.mu.RLock()
+	candidates := r.inBoxLocked(q.boundingBox())
+	r.mu.RUnlock()
+
+	var hits []GeoHit
+	for _, hit := range candidates {
+		hit.DistanceMeters = haversineMeters(q.Latitude, q.Longitude, hit.Latitude, hit.Longitude)
+		if hit.DistanceMeters <= q.RadiusMeters {
+			hits = append(hits, hit)
+		}
+	}
+	sort.Slice(hits, func(i, j int) bool {
+		if hits[i].DistanceMeters != hits[j].DistanceMeters {
+			return hits[i].DistanceMeters < hits[j].DistanceMeters
+		}
+		return hits[i].PhotoID < hits[j].PhotoID
+	})
+	return paginateHits(hits, q.Limit, q.Offset), nil
+}
+
+// InBox, kutu içindeki fotoğrafları yeniden eskiye sıralı döndürür.
+func (r *MemoryLocationRepository) InBox(ctx context.Context, q BoxQuery) ([]GeoHit, error) {
+	r.mu.RLock()
+	hits := r.inBoxLocked(q)
+	r.mu.RUnlock()
+
+	sort.Slice(hits, func(i, j int) bool { return hits[i].PhotoID > hits[j].PhotoID })
+	return paginateHits(hits, q.Limit, q.Offset), nil
+}
+
+func paginateHits(hits []GeoHit, limit, offset int) []GeoHit {
+	if offset >= len(hits) {
+		return nil
+	}
+	hits = hits[offset:]
+	if limit < len(hits) {
+		hits = hits[:limit]
+	}
+	return hits
+}
+
+// photoIDInt, string fotoğraf ID'sini dizinde kullanılan sayısal ID'ye çevirir.
+func photoIDInt(id string) (int64, error) {
+	return strconv.ParseInt(id, 10, 64)
+}
@@ -0,0 +1,159 @@
+package photo
+
+import (
+	"context"
+	"database/sql"
+	"os"
+	"reflect"
+	"testing"
+)
+
+// testDatabaseEnv, Postgres uygulamasının da sınanacağı veritabanının bağlantı dizesini verir. Tanımlı değilse
+// yalnızca bellek içi dizin sınanır.
+const testDatabaseEnv = "PHOTOS_TEST_DATABASE_URL"
+
+// testLocations, sıralama kurallarını sınayan konumlardır. 3 ile 4 aynı noktada, 5 ile 6 merkeze (1) tam olarak
+// eşit uzaklıktadır; 7 ve 8 180. meridyenin iki yanındadır.
+var testLocations = []GeoHit{
+	{PhotoID: 1, Latitude: 41, Longitude: 29},
+	{PhotoID: 2, Latitude: 41, Longitude: 29.01}, // Aşağıda Ankara'ya taşınır
+	{PhotoID: 4, Latitude: 41, Longitude: 29.0625},
+	{PhotoID: 3, Latitude: 41, Longitude: 29.0625},
+	{PhotoID: 6, Latitude: 41, Longitude: 29.125},
+	{PhotoID: 5, Latitude: 41, Longitude: 28.875},
+	{PhotoID: 7, Latitude: -17.7134, Longitude: 178.065},
+	{PhotoID: 8, Latitude: -13.7590, Longitude: -172.1},
+}
+
+func TestLocationRepositoryOrdering(t *testing.T) {
+	repos := map[string]LocationRepository{"memory": NewMemoryLocationRepository()}
+	if pg := openTestLocationRepository(t); pg != nil {
+		repos["postgres"] = pg
+	}
+
+	ctx := context.Background()
+	for name, repo := range repos {
+		for _, loc := range testLocations {
+			if err := repo.Upsert(ctx, loc.PhotoID, loc.Latitude, loc.Longitude); err != nil {
+				t.Fatalf("%s: Upsert: %v", name, err)
+			}
+		}
+		// Taşınan fotoğraf eski konumunun sorgularında görünmemelidir.
+		if err := repo.Upsert(ctx, 2, 39.9334, 32.8597); err != nil {
+			t.Fatalf("%s: Upsert: %v", name, err)
+		}
+	}
+
+	near := []struct {
+		name  string
+		query NearQuery
+		want  []int64
+	}{
+		{
+			name:  "mesafeye, eşitlikte ID'ye göre",
+			query: NearQuery{Latitude: 41, Longitude: 29, RadiusMeters: 20000, Limit: 10},
+			want:  []int64{1, 3, 4, 5, 6},
+		},
+		{
+			name:  "sayfalama",
+			query: NearQuery{Latitude: 41, Longitude: 29, RadiusMeters: 20000, Limit: 2, Offset: 2},
+			want:  []int64{4, 5},
+		},
+		{
+			name:  "yarıçap dışındakiler elenir",
+			query: NearQuery{Latitude: 41, Longitude: 29, RadiusMeters: 1000, Limit: 10},
+			want:  []int64{1},
+		},
+		{
+			name:  "180. meridyeni geçen yarıçap",
+			query: NearQuery{Latitude: -16, Longitude: 179.9, RadiusMeters: 1200000, Limit: 10},
+			want:  []int64{7, 8},
+		},
+	}
+	box := []struct {
+		name  string
+		query BoxQuery
+		want  []int64
+	}{
+		{
+			name:  "yeniden eskiye",
+			query: BoxQuery{MinLatitude: 39, MinLongitude: 28, MaxLatitude: 42, MaxLongitude: 33, Limit: 10},
+			want:  []int64{6, 5, 4, 3, 2, 1},
+		},
+		{
+			name:  "sayfalama",
+			query: BoxQuery{MinLatitude: 39, MinLongitude: 28, MaxLatitude: 42, MaxLongitude: 33, Limit: 2, Offset: 4},
+			want:  []int64{2, 1},
+		},
+		{
+			name:  "180. meridyeni geçen kutu",
+			query: BoxQuery{MinLatitude: -20, MinLongitude: 170, MaxLatitude: -10, MaxLongitude: -170, Limit: 10},
+			want:  []int64{8, 7},
+		},
+	}
+
+	for name, repo := range repos {
+		for _, tt := range near {
+			t.Run(name+"/Near/"+tt.name, func(t *testing.T) {
+				hits, err := repo.Near(ctx, tt.query)
+				if err != nil {
+					t.Fatalf("Near: %v", err)
+				}
+				if got := hitIDs(hits); !reflect.DeepEqual(got, tt.want) {
+					t.Errorf("Near = %v, want %v", got, tt.want)
+				}
+				for i := 1; i < len(hits); i++ {
+					if hits[i].DistanceMeters < hits[i-1].DistanceMeters {
+						t.Errorf("mesafeler artan sırada değil: %v", hits)
+					}
+				}
+			})
+		}
+		for _, tt := range box {
+			t.Run(name+"/InBox/"+tt.name, func(t *testing.T) {
+				hits, err := repo.InBox(ctx, tt.query)
+				if err != nil {
+					t.Fatalf("InBox: %v", err)
+				}
+				if got := hitIDs(hits); !reflect.DeepEqual(got, tt.want) {
+					t.Errorf("InBox = %v, want %v", got, tt.want)
+				}
+			})
+		}
+	}
+}
+
+func hitIDs(hits []GeoHit) []int64 {
+	ids := make([]int64, 0, len(hits))
+	for _, hit := range hits {
+		ids = append(ids, hit.PhotoID)
+	}
+	return ids
+}
+
+// openTestLocationRepository, testDatabaseEnv tanımlıysa geçici bir photo_locations tablosu kullanan Postgres
+// dizinini döndürür. Geçici tablo oturuma özel olduğundan havuz tek bağlantıyla sınırlanır.
+func openTestLocationRepository(t *testing.T) *PostgresLocationRepository {
+	dsn := os.Getenv(testDatabaseEnv)
+	if dsn == "" {
+		t.Logf("%s tanımlı değil, Postgres dizini sınanmıyor", testDatabaseEnv)
+		return nil
+	}
+
+	testDB, err := sql.Open("pgx", dsn)
+	if err != nil {
+		t.Fatalf("veritabanına bağlanılamadı: %v", err)
+	}
+	t.Cleanup(func() { testDB.Close() })
+	testDB.SetMaxOpenConns(1)
+
+	_, err = testDB.Exec(`CREATE TEMPORARY TABLE photo_locations (
+        photo_id INTEGER PRIMARY KEY,
+        latitude FLOAT NOT NULL,
+        longitude FLOAT NOT NULL
+    )`)
+	if err != nil {
+		t.Fatalf("geçici tablo oluşturulamadı: %v", err)
+	}
+	return NewPostgresLocationRepository(testDB)
+}
@@ -0,0 +1,148 @@
+package photo
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"log/slog"
+	"os"
+	"strings"
+
+	"myphotoapp/config"
+
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// requestIDHeader, istek kimliğinin gRPC üst verisinde, HTTP başlıklarında ve Kafka mesaj başlıklarında
+// taşındığı addır.
+const requestIDHeader = "x-request-id"
+
+// maxRequestIDLength, istemcinin gönderdiği istek kimliğinin kabul edilen en büyük uzunluğudur. Daha uzun ya
+// da yazdırılamayan karakter içeren kimliklerin yerine yenisi üretilir.
+const maxRequestIDLength = 128
+
+type requestIDKey struct{}
+
+// InitLogging, yapılandırılan biçim ve seviyeyle varsayılan slog günlükçüsünü kurar. Standart log paketiyle
+// yazılan günlükler de bu günlükçüye yönlendirilir.
+func InitLogging(cfg config.LogConfig) error {
+	var level slog.Level
+	if cfg.Level != "" {
+		if err := level.UnmarshalText([]byte(cfg.Level)); err != nil {
+			return fmt.Errorf("geçersiz günlük seviyesi %q: %v", cfg.Level, err)
+		}
+	}
+
+	opts := &slog.HandlerOptions{Level: level}
+	var handler slog.Handler
+	switch strings.ToLower(cfg.Format) {
+	case "", "text":
+		handler = slog.NewTextHandler(os.Stderr, opts)
+	case "json":
+		handler = slog.NewJSONHandler(os.Stderr, opts)
+	default:
+		return fmt.Errorf("bilinmeyen günlük biçimi: %q", cfg.Format)
+	}
+	slog.SetDefault(slog.New(contextHandler{handler}))
+	return nil
+}
+
+// contextHandler, bağlamdaki istek kimliğini ve iz kimliğini her kayda ekler. Böylece *Context günlük
+// fonksiyonlarına bağlam verilen her yerde kayıtlar isteğe bağlanabilir.
+type contextHandler struct {
+	slog.Handler
+}
+
+func (h contextHandler) Handle(ctx context.Context, r slog.Record) error {
+	if id := RequestIDFromContext(ctx); id != "" {
+		r.AddAttrs(slog.String("request_id", id))
+	}
+	if sc := trace.SpanContextFromContext(ctx); sc.IsValid() {
+		r.AddAttrs(slog.String("trace_id", sc.TraceID().String()))
+	}
+	return h.Handler.Handle(ctx, r)
+}
+
+func (h contextHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return contextHandler{h.Handler.WithAttrs(attrs)}
+}
+
+func (h contextHandler) WithGroup(name string) slog.Handler {
+	return contextHandler{h.Handler.WithGroup(name)}
+}
+
+// WithRequestID, istek kimliğini bağlama ekler.
+func WithRequestID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, requestIDKey{}, id)
+}
+
+// RequestIDFromContext, bağlamdaki istek kimliğini döndürür; yoksa boş dize döner.
+func RequestIDFromContext(ctx context.Context) string {
+	id, _ := ctx.Value(requestIDKey{}).(string)
+	return id
+}
+
+// newRequestID, rastgele 16 baytlık bir istek kimliği üretir.
+func newRequestID() string {
+	var b [16]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		return ""
+	}
+	return hex.EncodeToString(b[:])
+}
+
+// validRequestID, istemcinin gönderdiği kimliğin günlüklere yazılabilecek biçimde olduğunu doğrular.
+func validRequestID(id string) bool {
+	if id == "" || len(id) > maxRequestIDLength {
+		return false
+	}
+	for _, c := range id {
+		if c <= ' ' || c > '~' {
+			return false
+		}
+	}
+	return true
+}
+
+// requestContext, gelen üst veride geçerli bir istek kimliği varsa onu, yoksa yeni bir kimliği bağlama ekler
+// ve kimliği yanıt başlığında istemciye bildirir.
+func requestContext(ctx context.Context) context.Context {
+	var id string
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if ids := md.Get(requestIDHeader); len(ids) > 0 && validRequestID(ids[0]) {
+			id = ids[0]
+		}
+	}
+	if id == "" {
+		id = newRequestID()
+	}
+	_ = grpc.SetHeader(ctx, metadata.Pairs(requestIDHeader, id))
+	return WithRequestID(ctx, id)
+}
+
+// RequestIDUnaryInterceptor, tekli RPC'lerin bağlamına istek kimliği ekler.
+func RequestIDUnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		return handler(requestContext(ctx), req)
+	}
+}
+
+// RequestIDStreamInterceptor, akış RPC'lerinin bağlamına istek kimliği ekler.
+func RequestIDStreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return handler(srv, &requestIDStream{ServerStream: ss, ctx: requestContext(ss.Context())})
+	}
+}
+
+// requestIDStream, akışın bağlamını istek kimliği eklenmiş bağlamla değiştirir.
+type requestIDStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *requestIDStream) Context() context.Context {
+	return s.ctx
+}
@@ -0,0 +1,69 @@
+package photo
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"strings"
+	"testing"
+
+	"myphotoapp/config"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestInitLoggingRejectsUnknownSettings(t *testing.T) {
+	defer slog.SetDefault(slog.Default())
+
+	if err := InitLogging(config.LogConfig{Format: "xml"}); err == nil {
+		t.Error("bilinmeyen biçim kabul edildi")
+	}
+	if err := InitLogging(config.LogConfig{Level: "çok"}); err == nil {
+		t.Error("bilinmeyen seviye kabul edildi")
+	}
+	if err := InitLogging(config.LogConfig{Format: "json", Level: "warn"}); err != nil {
+		t.Errorf("InitLogging(json, warn) = %v", err)
+	}
+}
+
+func TestContextHandlerAddsRequestID(t *testing.T) {
+	var buf bytes.Buffer
+	logger := slog.New(contextHandler{slog.NewTextHandler(&buf, nil)})
+
+	logger.InfoContext(WithRequestID(context.Background(), "abc123"), "Fotoğraf yüklendi")
+	if !strings.Contains(buf.String(), "request_id=abc123") {
+		t.Errorf("günlükte istek kimliği yok: %s", buf.String())
+	}
+}
+
+func TestRequestIDUnaryInterceptor(t *testing.T) {
+	tests := []struct {
+		name     string
+		incoming string
+		want     string // boşsa yeni bir kimlik üretilmelidir
+	}{
+		{"geçerli kimlik korunur", "istek-1", "istek-1"},
+		{"boşluk içeren kimlik değiştirilir", "kötü kimlik", ""},
+		{"uzun kimlik değiştirilir", strings.Repeat("a", maxRequestIDLength+1), ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(requestIDHeader, tt.incoming))
+			var got string
+			handler := func(ctx context.Context, req any) (any, error) {
+				got = RequestIDFromContext(ctx)
+				return nil, nil
+			}
+			if _, err := RequestIDUnaryInterceptor()(ctx, nil, &grpc.UnaryServerInfo{}, handler); err != nil {
+				t.Fatal(err)
+			}
+			switch {
+			case tt.want != "" && got != tt.want:
+				t.Errorf("istek kimliği = %q, want %q", got, tt.want)
+			case tt.want == "" && (got == tt.incoming || !validRequestID(got)):
+				t.Errorf("istek kimliği = %q, want yeni üretilmiş kimlik", got)
+			}
+		})
+	}
+}
@@ -0,0 +1,146 @@
+package photo
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+const metricsNamespace = "myphotoapp"
+
+// Paketin Prometheus ölçümleri. Ölçümler bileşenlerde doğrudan güncellenir ve NewMetricsRegistry ile tek bir
+// kayıtta toplanır.
+var (
+	rpcDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: metricsNamespace,
+		Subsystem: "grpc",
+		Name:      "server_handling_seconds",
+		Help:      "RPC'lerin tamamlanma süresi; servis, metot ve gRPC durum koduna göre.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"service", "method", "code"})
+
+	visionDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: metricsNamespace,
+		Subsystem: "vision",
+		Name:      "request_duration_seconds",
+		Help:      "Tek bir AnnotateImage denemesinin süresi; gRPC durum koduna göre.",
+		Buckets:   []float64{0.1, 0.25, 0.5, 1, 2, 4, 8, 15},
+	}, []string{"code"})
+
+	visionErrors = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Subsystem: "vision",
+		Name:      "errors_total",
+		Help:      "Başarısız AnnotateImage denemeleri; gRPC durum koduna göre.",
+	}, []string{"code"})
+
+	facesPerImage = prometheus.NewHistogram(prometheus.HistogramOpts{
+		Namespace: metricsNamespace,
+		Subsystem: "vision",
+		Name:      "faces_per_image",
+		Help:      "Vision API'nin bir görüntüde bulduğu yüz sayısı.",
+		Buckets:   []float64{0, 1, 2, 3, 5, 8, 13, 20},
+	})
+
+	kafkaDeliveries = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Subsystem: "kafka",
+		Name:      "deliveries_total",
+		Help:      "Kafka'ya gönderilen mesajların teslim sonuçları; konu ve sonuca (success, failure) göre.",
+	}, []string{"topic", "result"})
+
+	kafkaUndelivered = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Subsystem: "kafka",
+		Name:      "undelivered_total",
+		Help:      "Kapanışta süre sınırı içinde teslim edilemeyen Kafka mesajları.",
+	})
+
+	analysisBacklog = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: metricsNamespace,
+		Subsystem: "analysis_queue",
+		Name:      "backlog",
+		Help:      "Vision API'ye ulaşılamadığı için analizi bekleyen fotoğraf sayısı.",
+	})
+
+	feedQueryDuration = prometheus.NewHistogram(prometheus.HistogramOpts{
+		Namespace: metricsNamespace,
+		Subsystem: "feed",
+		Name:      "query_duration_seconds",
+		Help:      "Akış sorgusunun veritabanında çalışma süresi.",
+		Buckets:   []float64{0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5},
+	})
+)
+
+// NewMetricsRegistry, paketin ölçümlerini, Go çalışma zamanı ve süreç ölçümlerini, veritabanı bağlantı
+// havuzunun durumunu ve Kafka üreticisinin gönderim kuyruğunu bir kayıtta toplar. InitDB'den sonra
+// çağrılmalıdır.
+func NewMetricsRegistry(kafka *KafkaProducer) *prometheus.Registry {
+	reg := prometheus.NewRegistry()
+	reg.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		collectors.NewDBStatsCollector(db, "photos"),
+		rpcDuration,
+		visionDuration,
+		visionErrors,
+		facesPerImage,
+		kafkaDeliveries,
+		kafkaUndelivered,
+		analysisBacklog,
+		feedQueryDuration,
+		prometheus.NewGaugeFunc(prometheus.GaugeOpts{
+			Namespace: metricsNamespace,
+			Subsystem: "kafka",
+			Name:      "producer_queue_length",
+			Help:      "Kafka üreticisinde henüz teslim edilmemiş mesaj sayısı.",
+		}, func() float64 { return float64(kafka.Len()) }),
+	)
+	return reg
+}
+
+// MetricsHandler, kayıttaki ölçümleri Prometheus biçiminde sunan HTTP işleyicisini döndürür.
+func MetricsHandler(reg *prometheus.Registry) http.Handler {
+	return promhttp.HandlerFor(reg, promhttp.HandlerOpts{Registry: reg})
+}
+
+// MetricsUnaryInterceptor, tekli RPC'lerin süresini ve durum kodunu ölçer.
+func MetricsUnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		start := time.Now()
+		resp, err := handler(ctx, req)
+		observeRPC(info.FullMethod, start, err)
+		return resp, err
+	}
+}
+
+// MetricsStreamInterceptor, akış RPC'lerinin akış kapanana kadar geçen süresini ve durum kodunu ölçer.
+func MetricsStreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		start := time.Now()
+		err := handler(srv, ss)
+		observeRPC(info.FullMethod, start, err)
+		return err
+	}
+}
+
+func observeRPC(fullMethod string, start time.Time, err error) {
+	service, method := splitFullMethod(fullMethod)
+	rpcDuration.WithLabelValues(service, method, status.Code(err).String()).Observe(time.Since(start).Seconds())
+}
+
+// splitFullMethod, "/paket.Servis/Metot" biçimindeki adı servis ve metot olarak ayırır.
+func splitFullMethod(fullMethod string) (string, string) {
+	fullMethod = strings.TrimPrefix(fullMethod, "/")
+	if i := strings.LastIndex(fullMethod, "/"); i >= 0 {
+		return fullMethod[:i], fullMethod[i+1:]
+	}
+	return "unknown", fullMethod
+}
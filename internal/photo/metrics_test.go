@@ -0,0 +1,48 @@
+package photo
+
+import (
+	"context"
+	"io"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestSplitFullMethod(t *testing.T) {
+	tests := []struct{ in, service, method string }{
+		{PhotoService_UploadImage_FullMethodName, "photo.PhotoService", "UploadImage"},
+		{"Bozuk", "unknown", "Bozuk"},
+	}
+	for _, tt := range tests {
+		if service, method := splitFullMethod(tt.in); service != tt.service || method != tt.method {
+			t.Errorf("splitFullMethod(%q) = %q, %q, want %q, %q", tt.in, service, method, tt.service, tt.method)
+		}
+	}
+}
+
+func TestMetricsUnaryInterceptorRecordsCode(t *testing.T) {
+	reg := prometheus.NewRegistry()
+	reg.MustRegister(rpcDuration)
+	rpcDuration.Reset()
+
+	info := &grpc.UnaryServerInfo{FullMethod: PhotoService_GetImageDetail_FullMethodName}
+	handler := func(ctx context.Context, req any) (any, error) {
+		return nil, status.Error(codes.NotFound, "fotoğraf bulunamadı")
+	}
+	if _, err := MetricsUnaryInterceptor()(context.Background(), nil, info, handler); status.Code(err) != codes.NotFound {
+		t.Fatalf("interceptor hatası = %v, want NotFound", err)
+	}
+
+	rec := httptest.NewRecorder()
+	MetricsHandler(reg).ServeHTTP(rec, httptest.NewRequest("GET", "/metrics", nil))
+	body, _ := io.ReadAll(rec.Body)
+	want := `myphotoapp_grpc_server_handling_seconds_count{code="NotFound",method="GetImageDetail",service="photo.PhotoService"} 1`
+	if !strings.Contains(string(body), want) {
+		t.Errorf("/metrics yanıtında %q yok:\n%s", want, body)
+	}
+}
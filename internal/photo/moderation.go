@@ -0,0 +1,335 @@
+package photo
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sort"
+	"strconv"
+	"strings"
+
+	"myphotoapp/config"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Veritabanında saklanan denetim durumu değerleri.
+const (
+	moderationAccepted    = "ACCEPTED"
+	moderationQuarantined = "QUARANTINED"
+	moderationRejected    = "REJECTED"
+	moderationPending     = "PENDING"
+)
+
+// systemModerator, otomatik SafeSearch kararlarında moderatör olarak kaydedilir.
+const systemModerator = "system"
+
+// moderationCategories, yapılandırmada kullanılabilecek SafeSearch kategorileridir.
+var moderationCategories = []string{"adult", "violence", "racy", "medical", "spoof"}
+
+// ModerationPolicy, SafeSearch sonuçlarını kategori eşiklerine göre kabul, karantina veya ret kararına çevirir.
+type ModerationPolicy struct {
+	enabled    bool
+	quarantine map[string]Likelihood
+	reject     map[string]Likelihood
+}
+
+// NewModerationPolicy, yapılandırmadaki eşikleri doğrular ve bir ModerationPolicy oluşturur.
+func NewModerationPolicy(cfg config.ModerationConfig) (*ModerationPolicy, error) {
+	quarantine, err := parseModerationThresholds(cfg.Quarantine)
+	if err != nil {
+		return nil, fmt.Errorf("karantina eşikleri geçersiz: %v", err)
+	}
+	reject, err := parseModerationThresholds(cfg.Reject)
+	if err != nil {
+		return nil, fmt.Errorf("ret eşikleri geçersiz: %v", err)
+	}
+
+	return &ModerationPolicy{
+		enabled:    cfg.Enabled,
+		quarantine: quarantine,
+		reject:     reject,
+	}, nil
+}
+
+// parseModerationThresholds, kategori -> olasılık adı eşlemesini doğrulayarak Likelihood değerlerine çevirir.
+func parseModerationThresholds(raw map[string]string) (map[string]Likelihood, error) {
+	thresholds := make(map[string]Likelihood, len(raw))
+	for category, name := range raw {
+		category = strings.ToLower(category)
+		if !isModerationCategory(category) {
+			return nil, fmt.Errorf("bilinmeyen kategori: %q", category)
+		}
+		value, ok := Likelihood_value["LIKELIHOOD_"+strings.ToUpper(name)]
+		if !ok || Likelihood(value) <= Likelihood_LIKELIHOOD_VERY_UNLIKELY {
+			return nil, fmt.Errorf("%s için geçersiz olasılık: %q", category, name)
+		}
+		thresholds[category] = Likelihood(value)
+	}
+	return thresholds, nil
+}
+
+func isModerationCategory(category string) bool {
+	for _, c := range moderationCategories {
+		if c == category {
+			return true
+		}
+	}
+	return false
+}
+
+// Enabled, denetimin açık olup olmadığını döndürür. Kapalıysa SafeSearch istenmez.
+func (p *ModerationPolicy) Enabled() bool {
+	return p != nil && p.enabled
+}
+
+// Evaluate, SafeSearch sonucuna göre denetim kararını ve gerekçesini döndürür.
+// Ret eşikleri karantina eşiklerinden önce değerlendirilir.
+func (p *ModerationPolicy) Evaluate(safeSearch *SafeSearch) (ModerationStatus, string) {
+	if !p.Enabled() {
+		return ModerationStatus_MODERATION_ACCEPTED, ""
+	}
+	if safeSearch == nil {
+		// Sonuç alınamadıysa fotoğraf moderatör incelemesine bırakılır.
+		return ModerationStatus_MODERATION_QUARANTINED, "SafeSearch sonucu alınamadı"
+	}
+
+	if reasons := exceededThresholds(safeSearch, p.reject); len(reasons) > 0 {
+		return ModerationStatus_MODERATION_REJECTED, strings.Join(reasons, "; ")
+	}
+	if reasons := exceededThresholds(safeSearch, p.quarantine); len(reasons) > 0 {
+		return ModerationStatus_MODERATION_QUARANTINED, strings.Join(reasons, "; ")
+	}
+	return ModerationStatus_MODERATION_ACCEPTED, ""
+}
+
+// exceededThresholds, eşiğine ulaşılan kategorileri "kategori=DEĞER (eşik EŞİK)" biçiminde, sıralı döndürür.
+func exceededThresholds(safeSearch *SafeSearch, thresholds map[string]Likelihood) []string {
+	var reasons []string
+	for category, threshold := range thresholds {
+		value := safeSearchValue(safeSearch, category)
+		if value >= threshold {
+			reasons = append(reasons, fmt.Sprintf("%s=%s (eşik %s)", category, likelihoodName(value), likelihoodName(threshold)))
+		}
+	}
+	sort.Strings(reasons)
+	return reasons
+}
+
+func safeSearchValue(safeSearch *SafeSearch, category string) Likelihood {
+	switch category {
+	case "adult":
+		return safeSearch.Adult
+	case "violence":
+		return safeSearch.Violence
+	case "racy":
+		return safeSearch.Racy
+	case "medical":
+		return safeSearch.Medical
+	case "spoof":
+		return safeSearch.Spoof
+	}
+	return Likelihood_LIKELIHOOD_UNKNOWN
+}
+
+// likelihoodName, Likelihood değerini yapılandırmadaki adıyla (örneğin, "LIKELY") döndürür.
+func likelihoodName(l Likelihood) string {
+	return strings.TrimPrefix(l.String(), "LIKELIHOOD_")
+}
+
+// moderationStatusToDB, denetim durumunu veritabanı değerine çevirir.
+func moderationStatusToDB(s ModerationStatus) string {
+	switch s {
+	case ModerationStatus_MODERATION_QUARANTINED:
+		return moderationQuarantined
+	case ModerationStatus_MODERATION_REJECTED:
+		return moderationRejected
+	case ModerationStatus_MODERATION_PENDING:
+		return moderationPending
+	}
+	return moderationAccepted
+}
+
+// moderationStatusFromDB, veritabanı değerini denetim durumuna çevirir.
+func moderationStatusFromDB(s string) ModerationStatus {
+	switch s {
+	case moderationAccepted:
+		return ModerationStatus_MODERATION_ACCEPTED
+	case moderationQuarantined:
+		return ModerationStatus_MODERATION_QUARANTINED
+	case moderationRejected:
+		return ModerationStatus_MODERATION_REJECTED
+	case moderationPending:
+		return ModerationStatus_MODERATION_PENDING
+	}
+	return ModerationStatus_MODERATION_STATUS_UNSPECIFIED
+}
+
+// insertModerationDecision, bir denetim kararını geçmiş tablosuna verilen işlem içinde ekler.
+func insertModerationDecision(tx *sql.Tx, photoID any, decision, moderatorID, reason string) error {
+	_, err := tx.Exec(`INSERT INTO moderation_decisions (photo_id, decision, moderator_id, reason, decided_at)
+                       VALUES ($1, $2, $3, $4, $5)`,
+		photoID, decision, moderatorID, reason, now().UTC())
+	return err
+}
+
+// ModerationServer, ModerationService gRPC hizmetini uygular.
+type ModerationServer struct {
+	UnimplementedModerationServiceServer
+	locations  LocationRepository
+	moderators map[string]bool
+}
+
+// NewModerationServer, yeni bir ModerationServer örneği oluşturur. Yapılandırmada moderatör yoksa hizmetin
+// tüm çağrıları reddedilir. Kararlar fotoğrafın görünürlüğünü değiştirdiği için konum dizini de güncellenir.
+func NewModerationServer(locations LocationRepository, cfg config.ModerationConfig) *ModerationServer {
+	moderators := make(map[string]bool, len(cfg.Moderators))
+	for _, id := range cfg.Moderators {
+		if id = strings.TrimSpace(id); id != "" {
+			moderators[id] = true
+		}
+	}
+	if len(moderators) == 0 {
+		slog.Warn("Moderatör yapılandırılmadı; denetim hizmeti tüm istekleri reddedecek")
+	}
+	return &ModerationServer{locations: locations, moderators: moderators}
+}
+
+// moderator, isteği yapan moderatörün doğrulanmış kimliğini döndürür. Kimlik moderatör listesinde olmalıdır;
+// istekte moderator_id gönderilmişse bu kimlikle aynı olmalıdır. Kararlara her zaman doğrulanmış kimlik yazılır.
+func (m *ModerationServer) moderator(ctx context.Context, requested string) (string, error) {
+	identity := clientIdentity(ctx)
+	if identity == "" || !m.moderators[identity] {
+		slog.WarnContext(ctx, "Yetkisiz denetim isteği", "client_id", identity)
+		return "", status.Error(codes.PermissionDenied, "denetim yetkisi yok")
+	}
+	if requested = strings.TrimSpace(requested); requested != "" && requested != identity {
+		return "", status.Error(codes.PermissionDenied, "moderatör ID'si çağıranın kimliğiyle eşleşmiyor")
+	}
+	return identity, nil
+}
+
+// ListQuarantined, karantinadaki fotoğrafları en eskiden başlayarak listeler.
+// Kararlar listeyi kısalttığı için sayfalama son görülen ID'ye göre yapılır.
+func (m *ModerationServer) ListQuarantined(ctx context.Context, req *ListQuarantinedRequest) (*ListQuarantinedResponse, error) {
+	if _, err := m.moderator(ctx, ""); err != nil {
+		return nil, err
+	}
+	pageSize := clampPageSize(req.PageSize)
+	page, err := resolvePage(req.PageToken, 0, pageSize, "quarantine")
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := db.QueryContext(ctx, `SELECT `+photoColumns+` FROM photos
+                           WHERE moderation_status = $1 AND id > $2
+                           ORDER BY id
+                           LIMIT $3`, moderationQuarantined, page.After, pageSize+1)
+	if err != nil {
+		slog.ErrorContext(ctx, "Karantinadaki fotoğraflar alınamadı", "error", err)
+		return nil, fmt.Errorf("Karantinadaki fotoğraflar alınamadı: %v", err)
+	}
+	defer rows.Close()
+
+	var images []*UploadedImage
+	for rows.Next() {
+		img, err := scanPhoto(rows)
+		if err != nil {
+			return nil, fmt.Errorf("Fotoğraf alınamadı: %v", err)
+		}
+		images = append(images, img)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("Karantinadaki fotoğraflar alınamadı: %v", err)
+	}
+
+	response := &ListQuarantinedResponse{}
+	if len(images) > pageSize {
+		images = images[:pageSize]
+		next := page
+		next.After, _ = strconv.ParseInt(images[pageSize-1].Id, 10, 64)
+		response.NextPageToken = encodePageToken(next)
+	}
+	if err := loadImageDetails(ctx, images); err != nil {
+		return nil, fmt.Errorf("Fotoğraf ayrıntıları alınamadı: %v", err)
+	}
+	response.Images = hideForeignPersons(ctx, images)
+	return response, nil
+}
+
+// ApprovePhoto, karantinadaki bir fotoğrafı kabul eder ve akışta görünür hâle getirir.
+func (m *ModerationServer) ApprovePhoto(ctx context.Context, req *ModerationDecisionRequest) (*UploadedImage, error) {
+	moderatorID, err := m.moderator(ctx, req.ModeratorId)
+	if err != nil {
+		return nil, err
+	}
+	return m.decide(ctx, req, moderationAccepted, moderatorID)
+}
+
+// RejectPhoto, karantinadaki bir fotoğrafı reddeder. Reddedilen fotoğraflar hiçbir listede gösterilmez.
+func (m *ModerationServer) RejectPhoto(ctx context.Context, req *ModerationDecisionRequest) (*UploadedImage, error) {
+	moderatorID, err := m.moderator(ctx, req.ModeratorId)
+	if err != nil {
+		return nil, err
+	}
+	if strings.TrimSpace(req.Reason) == "" {
+		return nil, status.Error(codes.InvalidArgument, "ret gerekçesi boş olamaz")
+	}
+	return m.decide(ctx, req, moderationRejected, moderatorID)
+}
+
+// decide, kararı uygular ve fotoğrafın konum dizinindeki kaydını yeni durumuna göre günceller.
+func (m *ModerationServer) decide(ctx context.Context, req *ModerationDecisionRequest, decision, moderatorID string) (*UploadedImage, error) {
+	img, err := decideQuarantined(ctx, req, decision, moderatorID)
+	if err != nil {
+		return nil, err
+	}
+	syncLocationIndex(ctx, m.locations, img)
+	return img, nil
+}
+
+// decideQuarantined, karantinadaki bir fotoğrafın durumunu değiştirir ve kararı moderatörün doğrulanmış
+// kimliği ve gerekçesiyle kaydeder.
+func decideQuarantined(ctx context.Context, req *ModerationDecisionRequest, decision, moderatorID string) (*UploadedImage, error) {
+	if req.ImageId == "" {
+		return nil, status.Error(codes.InvalidArgument, "fotoğraf ID'si boş olamaz")
+	}
+
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	var current string
+	err = tx.QueryRowContext(ctx, `SELECT moderation_status FROM photos WHERE id = $1 FOR UPDATE`, req.ImageId).Scan(&current)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, status.Errorf(codes.NotFound, "fotoğraf bulunamadı: %s", req.ImageId)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("Fotoğraf bulunamadı: %v", err)
+	}
+	if current != moderationQuarantined {
+		return nil, status.Errorf(codes.FailedPrecondition, "fotoğraf karantinada değil: %s", current)
+	}
+
+	_, err = tx.ExecContext(ctx, `UPDATE photos SET moderation_status = $2, moderation_reason = NULLIF($3, '') WHERE id = $1`,
+		req.ImageId, decision, req.Reason)
+	if err != nil {
+		slog.ErrorContext(ctx, "Denetim kararı kaydedilemedi", "error", err)
+		return nil, fmt.Errorf("Denetim kararı kaydedilemedi: %v", err)
+	}
+	if err := insertModerationDecision(tx, req.ImageId, decision, moderatorID, req.Reason); err != nil {
+		slog.ErrorContext(ctx, "Denetim kararı kaydedilemedi", "error", err)
+		return nil, fmt.Errorf("Denetim kararı kaydedilemedi: %v", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("Denetim kararı kaydedilemedi: %v", err)
+	}
+
+	slog.InfoContext(ctx, "Denetim kararı verildi", "photo_id", req.ImageId, "decision", decision, "moderator_id", moderatorID)
+	return GetPhotoByID(ctx, req.ImageId)
+}
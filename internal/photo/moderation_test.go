@@ -0,0 +1,60 @@
+package photo
+
+import (
+	"context"
+	"testing"
+
+	"myphotoapp/config"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestModerationServerModerator(t *testing.T) {
+	gated := NewModerationServer(nil, config.ModerationConfig{Moderators: []string{"203.0.113.7", " "}})
+	unconfigured := NewModerationServer(nil, config.ModerationConfig{})
+
+	tests := []struct {
+		name      string
+		server    *ModerationServer
+		ctx       context.Context
+		requested string
+		want      string
+		wantCode  codes.Code
+	}{
+		{"listedeki çağıran", gated, peerContext("203.0.113.7"), "", "203.0.113.7", codes.OK},
+		{"aynı moderator_id", gated, peerContext("203.0.113.7"), "203.0.113.7", "203.0.113.7", codes.OK},
+		{"başkası adına karar", gated, peerContext("203.0.113.7"), "someone-else", "", codes.PermissionDenied},
+		{"listede olmayan çağıran", gated, peerContext("198.51.100.1"), "203.0.113.7", "", codes.PermissionDenied},
+		{"kimliksiz çağrı", gated, context.Background(), "203.0.113.7", "", codes.PermissionDenied},
+		{"moderatör yapılandırılmamış", unconfigured, peerContext("203.0.113.7"), "ayse", "", codes.PermissionDenied},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.server.moderator(tt.ctx, tt.requested)
+			if code := status.Code(err); code != tt.wantCode {
+				t.Fatalf("moderator hatası = %v, want %s", err, tt.wantCode)
+			}
+			if got != tt.want {
+				t.Errorf("moderator = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestModerationServerFailsClosed(t *testing.T) {
+	// Moderatör yoksa istekler veritabanına ulaşmadan reddedilir.
+	m := NewModerationServer(nil, config.ModerationConfig{})
+	ctx := peerContext("203.0.113.7")
+
+	if _, err := m.ListQuarantined(ctx, &ListQuarantinedRequest{}); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("ListQuarantined = %v, want PermissionDenied", err)
+	}
+	req := &ModerationDecisionRequest{ImageId: "1", ModeratorId: "203.0.113.7", Reason: "uygunsuz"}
+	if _, err := m.ApprovePhoto(ctx, req); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("ApprovePhoto = %v, want PermissionDenied", err)
+	}
+	if _, err := m.RejectPhoto(ctx, req); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("RejectPhoto = %v, want PermissionDenied", err)
+	}
+}
@@ -0,0 +1,107 @@
+package photo
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"log/slog"
+)
+
+// toProtoText, Vision API OCR sonucunu proto mesajına dönüştürür.
+func toProtoText(text *TextResult) *DetectedText {
+	if text == nil {
+		return nil
+	}
+
+	detected := &DetectedText{
+		FullText: text.FullText,
+		Language: text.Language,
+	}
+	for _, block := range text.Blocks {
+		detected.Blocks = append(detected.Blocks, &TextBlock{
+			Text:        block.Text,
+			Confidence:  float32(block.Confidence),
+			BoundingBox: block.BoundingBox,
+		})
+	}
+	return detected
+}
+
+// insertText, bir fotoğrafın OCR sonucunu ve bloklarını verilen işlem içinde ekler.
+func insertText(tx *sql.Tx, photoID int64, text *DetectedText) error {
+	if text == nil {
+		return nil
+	}
+
+	_, err := tx.Exec(`INSERT INTO photo_text (photo_id, full_text, language) VALUES ($1, $2, NULLIF($3, ''))`,
+		photoID, text.FullText, text.Language)
+	if err != nil {
+		return err
+	}
+
+	for i, block := range text.Blocks {
+		// Köşe noktaları [{"x":..,"y":..}] biçiminde JSONB olarak saklanır.
+		boundingBox, err := json.Marshal(block.BoundingBox)
+		if err != nil {
+			return err
+		}
+		_, err = tx.Exec(`INSERT INTO photo_text_blocks (photo_id, block_index, text, confidence, bounding_box)
+                          VALUES ($1, $2, $3, $4, $5)`,
+			photoID, i, block.Text, block.Confidence, string(boundingBox))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// replaceText, bir fotoğrafın OCR sonucunu verilen işlem içinde yenisiyle değiştirir.
+// Bloklar photo_text kaydına bağlı olduğundan onunla birlikte silinir.
+func replaceText(tx *sql.Tx, photoID int64, text *DetectedText) error {
+	if _, err := tx.Exec(`DELETE FROM photo_text WHERE photo_id = $1`, photoID); err != nil {
+		return err
+	}
+	return insertText(tx, photoID, text)
+}
+
+// loadText, bir fotoğrafın kayıtlı OCR sonucunu img.Text alanına yükler. Metin yoksa alan boş kalır.
+func loadText(ctx context.Context, img *UploadedImage) error {
+	text := &DetectedText{}
+	var language sql.NullString
+	err := db.QueryRowContext(ctx, `SELECT full_text, language FROM photo_text WHERE photo_id = $1`, img.Id).
+		Scan(&text.FullText, &language)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil
+	}
+	if err != nil {
+		slog.ErrorContext(ctx, "Fotoğraf metni alınamadı", "error", err)
+		return err
+	}
+	text.Language = language.String
+
+	rows, err := db.QueryContext(ctx, `SELECT text, confidence, bounding_box FROM photo_text_blocks
+                           WHERE photo_id = $1 ORDER BY block_index`, img.Id)
+	if err != nil {
+		slog.ErrorContext(ctx, "Fotoğraf metin blokları alınamadı", "error", err)
+		return err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		block := &TextBlock{}
+		var boundingBox []byte
+		if err := rows.Scan(&block.Text, &block.Confidence, &boundingBox); err != nil {
+			return err
+		}
+		if err := json.Unmarshal(boundingBox, &block.BoundingBox); err != nil {
+			return err
+		}
+		text.Blocks = append(text.Blocks, block)
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	img.Text = text
+	return nil
+}
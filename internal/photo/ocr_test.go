@@ -0,0 +1,44 @@
+package photo
+
+import (
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+)
+
+func TestToProtoText(t *testing.T) {
+	if got := toProtoText(nil); got != nil {
+		t.Errorf("metin istenmediğinde toProtoText = %v, want nil", got)
+	}
+
+	box := []*Vertex{{X: 1, Y: 2}, {X: 30, Y: 2}, {X: 30, Y: 12}, {X: 1, Y: 12}}
+	got := toProtoText(&TextResult{
+		FullText: "Fatura\nToplam 120 TL",
+		Language: "tr",
+		Blocks: []*TextBlockResult{
+			{Text: "Fatura", Confidence: 0.98, BoundingBox: box},
+			{Text: "Toplam 120 TL", Confidence: 0.9},
+		},
+	})
+	want := &DetectedText{
+		FullText: "Fatura\nToplam 120 TL",
+		Language: "tr",
+		Blocks: []*TextBlock{
+			{Text: "Fatura", Confidence: 0.98, BoundingBox: box},
+			{Text: "Toplam 120 TL", Confidence: 0.9},
+		},
+	}
+	if !proto.Equal(got, want) {
+		t.Errorf("toProtoText = %v, want %v", got, want)
+	}
+}
+
+func TestPhotoWithoutFaces(t *testing.T) {
+	// Ekran görüntüleri ve belgelerde yüz bulunmaz; fotoğraf duygusuz kaydedilir.
+	if got := toProtoFaces(nil); len(got) != 0 {
+		t.Errorf("toProtoFaces(nil) = %v, want boş", got)
+	}
+	if emotion, confidence := emotionColumns(nil); emotion != nil || confidence != nil {
+		t.Errorf("emotionColumns(nil) = %v, %v, want NULL", emotion, confidence)
+	}
+}
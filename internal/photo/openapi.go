@@ -0,0 +1,234 @@
+package photo
+
+import (
+	"encoding/json"
+	"sync"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// gatewayRoute, REST geçidinin bir yolunu ve karşılık geldiği RPC'yi tanımlar.
+type gatewayRoute struct {
+	method  string
+	path    string
+	rpc     protoreflect.Name
+	summary string
+	body    bool                // İstek mesajı JSON gövdesinden okunur
+	query   []queryParam        // Elle tanımlanan sorgu parametreleri
+	bindAll bool                // İstek mesajının bütün alanları sorgu parametresi olarak verilebilir
+	params  []protoreflect.Name // Yoldan okunan alanlar
+}
+
+type queryParam struct {
+	name   string
+	schema map[string]any
+}
+
+// gatewayRoutes, Gateway'in sunduğu yollardır. OpenAPI belgesi bu listeden ve proto tanımlarından üretilir.
+var gatewayRoutes = []gatewayRoute{
+	{method: "post", path: "/v1/images", rpc: "UploadImage", summary: "Yeni bir fotoğraf yükler", body: true},
+	{method: "get", path: "/v1/images/{id}", rpc: "GetImageDetail", summary: "Fotoğrafın ayrıntılarını verir",
+		params: []protoreflect.Name{"id"},
+		query:  []queryParam{{name: "refresh", schema: map[string]any{"type": "boolean"}}}},
+	{method: "patch", path: "/v1/images/{id}", rpc: "UpdateImageDetail", summary: "Fotoğrafı yeni URL ile yeniden analiz eder ve günceller",
+		body: true, params: []protoreflect.Name{"id"}},
+	{method: "get", path: "/v1/feed", rpc: "GetImageFeed", summary: "Denetimden geçmiş fotoğrafları listeler", bindAll: true},
+}
+
+var (
+	openAPIOnce sync.Once
+	openAPIDoc  []byte
+	openAPIErr  error
+)
+
+// OpenAPIDocument, REST geçidinin OpenAPI 3 belgesini PhotoService'in proto tanımlarından üretir.
+func OpenAPIDocument() ([]byte, error) {
+	openAPIOnce.Do(func() {
+		openAPIDoc, openAPIErr = json.MarshalIndent(buildOpenAPI(), "", "  ")
+	})
+	return openAPIDoc, openAPIErr
+}
+
+func buildOpenAPI() map[string]any {
+	service := File_proto_photo_upload_proto.Services().ByName("PhotoService")
+	schemas := map[string]any{}
+	schemas["google.rpc.Status"] = map[string]any{
+		"type": "object",
+		"properties": map[string]any{
+			"code":    map[string]any{"type": "integer", "format": "int32"},
+			"message": map[string]any{"type": "string"},
+			"details": map[string]any{"type": "array", "items": map[string]any{"type": "object"}},
+		},
+	}
+
+	paths := map[string]any{}
+	for _, route := range gatewayRoutes {
+		method := service.Methods().ByName(route.rpc)
+		input, output := method.Input(), method.Output()
+		addSchema(schemas, input)
+		addSchema(schemas, output)
+
+		op := map[string]any{
+			"operationId": string(route.rpc),
+			"summary":     route.summary,
+			"tags":        []string{string(service.Name())},
+			"responses": map[string]any{
+				"200": jsonContent("Başarılı yanıt", schemaRef(output)),
+				"default": jsonContent("gRPC durum koduna karşılık gelen HTTP durumuyla hata",
+					map[string]any{"$ref": "#/components/schemas/google.rpc.Status"}),
+			},
+		}
+
+		var parameters []any
+		for _, name := range route.params {
+			parameters = append(parameters, map[string]any{
+				"name": string(name), "in": "path", "required": true,
+				"schema": fieldSchema(input.Fields().ByName(name)),
+			})
+		}
+		for _, q := range route.query {
+			parameters = append(parameters, map[string]any{"name": q.name, "in": "query", "schema": q.schema})
+		}
+		if route.bindAll {
+			parameters = append(parameters, queryParameters(input, "", nil)...)
+		}
+		if len(parameters) > 0 {
+			op["parameters"] = parameters
+		}
+		if route.body {
+			op["requestBody"] = map[string]any{
+				"required": true,
+				"content":  map[string]any{"application/json": map[string]any{"schema": schemaRef(input)}},
+			}
+		}
+
+		item, _ := paths[route.path].(map[string]any)
+		if item == nil {
+			item = map[string]any{}
+			paths[route.path] = item
+		}
+		item[route.method] = op
+	}
+
+	return map[string]any{
+		"openapi": "3.0.3",
+		"info": map[string]any{
+			"title":   "MyPhotoApp REST API",
+			"version": "v1",
+		},
+		"paths":      paths,
+		"components": map[string]any{"schemas": schemas},
+	}
+}
+
+func jsonContent(description string, schema map[string]any) map[string]any {
+	return map[string]any{
+		"description": description,
+		"content":     map[string]any{"application/json": map[string]any{"schema": schema}},
+	}
+}
+
+func schemaRef(md protoreflect.MessageDescriptor) map[string]any {
+	return map[string]any{"$ref": "#/components/schemas/" + string(md.FullName())}
+}
+
+// addSchema, mesajın ve ulaşılabilen bütün mesajların şemalarını ekler.
+func addSchema(schemas map[string]any, md protoreflect.MessageDescriptor) {
+	name := string(md.FullName())
+	if _, ok := schemas[name]; ok {
+		return
+	}
+	properties := map[string]any{}
+	schema := map[string]any{"type": "object", "properties": properties}
+	schemas[name] = schema
+
+	fields := md.Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		properties[fd.JSONName()] = fieldSchema(fd)
+		if fd.IsMap() {
+			if v := fd.MapValue(); v.Kind() == protoreflect.MessageKind {
+				addSchema(schemas, v.Message())
+			}
+		} else if fd.Kind() == protoreflect.MessageKind {
+			addSchema(schemas, fd.Message())
+		}
+	}
+}
+
+// fieldSchema, alanın protojson kodlamasına uygun şemasını döndürür.
+func fieldSchema(fd protoreflect.FieldDescriptor) map[string]any {
+	if fd.IsMap() {
+		return map[string]any{"type": "object", "additionalProperties": singularSchema(fd.MapValue())}
+	}
+	if fd.IsList() {
+		return map[string]any{"type": "array", "items": singularSchema(fd)}
+	}
+	return singularSchema(fd)
+}
+
+func singularSchema(fd protoreflect.FieldDescriptor) map[string]any {
+	switch fd.Kind() {
+	case protoreflect.BoolKind:
+		return map[string]any{"type": "boolean"}
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		return map[string]any{"type": "integer", "format": "int32"}
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		return map[string]any{"type": "integer", "format": "int64", "minimum": 0}
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		// protojson 64 bitlik tamsayıları dize olarak kodlar.
+		return map[string]any{"type": "string", "format": "int64"}
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return map[string]any{"type": "string", "format": "uint64"}
+	case protoreflect.FloatKind:
+		return map[string]any{"type": "number", "format": "float"}
+	case protoreflect.DoubleKind:
+		return map[string]any{"type": "number", "format": "double"}
+	case protoreflect.StringKind:
+		return map[string]any{"type": "string"}
+	case protoreflect.BytesKind:
+		return map[string]any{"type": "string", "format": "byte"}
+	case protoreflect.EnumKind:
+		values := fd.Enum().Values()
+		names := make([]string, values.Len())
+		for i := range names {
+			names[i] = string(values.Get(i).Name())
+		}
+		return map[string]any{"type": "string", "enum": names}
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		return schemaRef(fd.Message())
+	}
+	return map[string]any{}
+}
+
+// queryParameters, mesajın sorgu parametresiyle verilebilen alanlarını iç içe mesajlar dahil noktalı adlarla
+// listeler.
+func queryParameters(md protoreflect.MessageDescriptor, prefix string, seen []protoreflect.FullName) []any {
+	for _, name := range seen {
+		if name == md.FullName() {
+			return nil
+		}
+	}
+	seen = append(seen, md.FullName())
+
+	var parameters []any
+	fields := md.Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		name := prefix + fd.JSONName()
+		switch {
+		case fd.IsMap():
+		case fd.Kind() == protoreflect.MessageKind:
+			if !fd.IsList() {
+				parameters = append(parameters, queryParameters(fd.Message(), name+".", seen)...)
+			}
+		default:
+			param := map[string]any{"name": name, "in": "query", "schema": fieldSchema(fd)}
+			if fd.IsList() {
+				param["explode"] = true
+			}
+			parameters = append(parameters, param)
+		}
+	}
+	return parameters
+}
@@ -0,0 +1,91 @@
+package photo
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	defaultPageSize = 10  // Sayfa büyüklüğü belirtilmediğinde kullanılır
+	maxPageSize     = 100 // Tek sayfada döndürülebilecek en fazla kayıt
+)
+
+// pageToken, sayfalı listelerde bir sonraki sayfanın nereden başlayacağını tutar.
+// İstemciye base64 ile kodlanmış, opak bir dize olarak verilir.
+type pageToken struct {
+	Offset   int     `json:"o"`           // Bir sonraki sayfanın başlangıç indeksi
+	Snapshot int64   `json:"s"`           // İlk sayfanın alındığı an (Unix saniye); sonradan yüklenenler sayfaları kaydırmaz
+	Filter   string  `json:"f,omitempty"` // İsteğin filtrelerinin özeti; başka bir sorguda kullanılmasını engeller
+	After    int64   `json:"a,omitempty"` // ID'ye göre sayfalanan listelerde son döndürülen kaydın ID'si
+	Seed     int64   `json:"r,omitempty"` // Rastgele sıralamanın tohumu; sayfalar arasında aynı sıra korunur
+	Score    float64 `json:"k,omitempty"` // Puana göre sayfalanan akışta son döndürülen kaydın puanı
+}
+
+// encodePageToken, bir pageToken'ı istemciye verilecek opak dizeye dönüştürür.
+func encodePageToken(t pageToken) string {
+	data, _ := json.Marshal(t)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// decodePageToken, istemciden gelen sayfa belirtecini çözer ve verilen filtre özetiyle eşleştiğini doğrular.
+func decodePageToken(token, filter string) (pageToken, error) {
+	var t pageToken
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return t, status.Error(codes.InvalidArgument, "geçersiz sayfa belirteci")
+	}
+	if err := json.Unmarshal(data, &t); err != nil || t.Offset < 0 {
+		return t, status.Error(codes.InvalidArgument, "geçersiz sayfa belirteci")
+	}
+	if t.Filter != filter {
+		return t, status.Error(codes.InvalidArgument, "sayfa belirteci bu sorguya ait değil")
+	}
+	return t, nil
+}
+
+// filterDigest, sayfa belirtecine gömülecek kısa bir filtre özeti üretir.
+func filterDigest(parts ...string) string {
+	sum := sha256.Sum256([]byte(strings.Join(parts, "\x00")))
+	return hex.EncodeToString(sum[:8])
+}
+
+// clampPageSize, istenen sayfa büyüklüğünü varsayılan ve üst sınıra göre düzeltir.
+func clampPageSize(size int32) int {
+	if size <= 0 {
+		return defaultPageSize
+	}
+	if size > maxPageSize {
+		return maxPageSize
+	}
+	return int(size)
+}
+
+// resolvePage, bir istekteki sayfa belirtecini veya sayfa numarasını başlangıç indeksine ve anlık görüntü zamanına çevirir.
+// Belirteç yoksa anlık görüntü şimdiki zamandır.
+func resolvePage(token string, pageNumber int32, pageSize int, filter string) (pageToken, error) {
+	if token != "" {
+		return decodePageToken(token, filter)
+	}
+
+	t := pageToken{Snapshot: now().Unix(), Filter: filter}
+	if pageNumber > 1 {
+		t.Offset = int(pageNumber-1) * pageSize
+	}
+	return t, nil
+}
+
+// nextPageToken, sonraki sayfada kayıt varsa onun belirtecini üretir. Sorgular bunu anlamak için
+// pageSize+1 kayıt ister.
+func nextPageToken(current pageToken, pageSize int, hasMore bool) string {
+	if !hasMore {
+		return ""
+	}
+	current.Offset += pageSize
+	return encodePageToken(current)
+}
@@ -0,0 +1,112 @@
+package photo
+
+import (
+	"encoding/base64"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestPageTokenRoundTrip(t *testing.T) {
+	tests := []pageToken{
+		{},
+		{Offset: 20, Snapshot: 1700000000, Filter: filterDigest("feed", "joy")},
+		{Offset: 30, Snapshot: 1700000000, Filter: filterDigest("search", "beach")},
+	}
+	for _, want := range tests {
+		got, err := decodePageToken(encodePageToken(want), want.Filter)
+		if err != nil {
+			t.Errorf("decodePageToken(%+v): %v", want, err)
+			continue
+		}
+		if got != want {
+			t.Errorf("decodePageToken = %+v, want %+v", got, want)
+		}
+	}
+}
+
+func TestDecodePageTokenRejects(t *testing.T) {
+	filter := filterDigest("search", "beach")
+
+	tests := []struct {
+		name   string
+		token  string
+		filter string
+	}{
+		{"base64 değil", "%%%", filter},
+		{"JSON değil", base64.RawURLEncoding.EncodeToString([]byte("not json")), filter},
+		{"negatif başlangıç", encodePageToken(pageToken{Offset: -1, Filter: filter}), filter},
+		{"başka sorgunun belirteci", encodePageToken(pageToken{Offset: 10, Filter: filterDigest("search", "sea")}), filter},
+		{"filtresiz belirteç", encodePageToken(pageToken{Offset: 10}), filter},
+		{"filtreli belirteç filtresiz sorguda", encodePageToken(pageToken{Offset: 10, Filter: filter}), ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := decodePageToken(tt.token, tt.filter)
+			if status.Code(err) != codes.InvalidArgument {
+				t.Errorf("decodePageToken = %v, want InvalidArgument", err)
+			}
+		})
+	}
+}
+
+func TestSearchParamsDigest(t *testing.T) {
+	base := searchParams{Query: "beach", Emotions: []string{"joy"}, OwnerID: "alice"}
+	variants := []searchParams{
+		{Query: "sea", Emotions: []string{"joy"}, OwnerID: "alice"},
+		{Query: "beach", Emotions: []string{"joy", "sorrow"}, OwnerID: "alice"},
+		{Query: "beach", Emotions: []string{"joy"}, OwnerID: "bob"},
+		{Query: "beach", Emotions: []string{"joy"}, OwnerID: "alice", UploadedAfter: time.Unix(1700000000, 0)},
+		{Query: "beach", Emotions: []string{"joy"}, OwnerID: "alice", UploadedBefore: time.Unix(1700000000, 0)},
+	}
+
+	if base.digest() != (searchParams{Query: "beach", Emotions: []string{"joy"}, OwnerID: "alice"}).digest() {
+		t.Fatal("aynı parametrelerin özetleri farklı")
+	}
+	token := encodePageToken(pageToken{Offset: 10, Filter: base.digest()})
+	for _, v := range variants {
+		if _, err := decodePageToken(token, v.digest()); status.Code(err) != codes.InvalidArgument {
+			t.Errorf("%+v için belirteç kabul edildi", v)
+		}
+	}
+}
+
+func TestNextPageToken(t *testing.T) {
+	current := pageToken{Offset: 10, Snapshot: 1700000000, Filter: "f"}
+	if got := nextPageToken(current, 10, false); got != "" {
+		t.Errorf("son sayfada nextPageToken = %q, want boş", got)
+	}
+
+	next, err := decodePageToken(nextPageToken(current, 10, true), "f")
+	if err != nil {
+		t.Fatalf("decodePageToken: %v", err)
+	}
+	if want := (pageToken{Offset: 20, Snapshot: 1700000000, Filter: "f"}); next != want {
+		t.Errorf("sonraki belirteç = %+v, want %+v", next, want)
+	}
+}
+
+func TestResolvePage(t *testing.T) {
+	defer func(orig func() time.Time) { now = orig }(now)
+	now = func() time.Time { return time.Unix(1700000000, 0) }
+
+	tests := []struct {
+		pageNumber int32
+		wantOffset int
+	}{
+		{0, 0},
+		{1, 0},
+		{3, 40},
+	}
+	for _, tt := range tests {
+		got, err := resolvePage("", tt.pageNumber, 20, "f")
+		if err != nil {
+			t.Fatalf("resolvePage(%d): %v", tt.pageNumber, err)
+		}
+		if want := (pageToken{Offset: tt.wantOffset, Snapshot: 1700000000, Filter: "f"}); got != want {
+			t.Errorf("resolvePage(%d) = %+v, want %+v", tt.pageNumber, got, want)
+		}
+	}
+}
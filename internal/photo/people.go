@@ -0,0 +1,396 @@
+package photo
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// maxPersonNameLength, bir kişi adının en fazla karakter sayısıdır.
+const maxPersonNameLength = 100
+
+// personColumns, scanPerson'ın beklediği sırayla persons tablosundan seçilen sütunlardır.
+const personColumns = `pe.id, pe.owner_id, pe.name, pe.created_at,
+        (SELECT count(*) FROM photo_faces f WHERE f.person_id = pe.id)`
+
+// PeopleServer, PeopleService gRPC hizmetini uygular.
+type PeopleServer struct {
+	UnimplementedPeopleServiceServer
+}
+
+// NewPeopleServer, yeni bir PeopleServer örneği oluşturur.
+func NewPeopleServer() *PeopleServer {
+	return &PeopleServer{}
+}
+
+// scanPerson, veritabanı satırını *Person türüne tarar.
+func scanPerson(row rowScanner) (*Person, error) {
+	person := &Person{}
+	var createdAt time.Time
+	if err := row.Scan(&person.Id, &person.OwnerId, &person.Name, &createdAt, &person.FaceCount); err != nil {
+		return nil, err
+	}
+	person.CreatedAt = createdAt.Unix()
+	return person, nil
+}
+
+// parsePersonID, istemciden gelen kişi ID'sini doğrular.
+func parsePersonID(id string) (int64, error) {
+	personID, err := strconv.ParseInt(id, 10, 64)
+	if err != nil || personID <= 0 {
+		return 0, status.Errorf(codes.InvalidArgument, "geçersiz kişi ID'si: %q", id)
+	}
+	return personID, nil
+}
+
+// personOwner, kişi işlemlerinde sahibi isteği yapan istemcinin doğrulanmış kimliğinden belirler.
+// Kimliği bilinmeyen çağrılar reddedilir.
+func personOwner(ctx context.Context) (string, error) {
+	ownerID := clientIdentity(ctx)
+	if ownerID == "" {
+		return "", status.Error(codes.PermissionDenied, "kişi işlemleri için istemci kimliği gereklidir")
+	}
+	return ownerID, nil
+}
+
+// hideForeignPersons, kişi atamalarını yalnızca fotoğrafın sahibine gösterir. Diğer istemcilere dönen
+// fotoğrafların kopyalarında yüzlerin kişi bilgisi boşaltılır; verilen fotoğraflar değiştirilmez.
+func hideForeignPersons(ctx context.Context, images []*UploadedImage) []*UploadedImage {
+	viewer := clientIdentity(ctx)
+	visible := make([]*UploadedImage, len(images))
+	for i, img := range images {
+		visible[i] = personsVisibleTo(viewer, img)
+	}
+	return visible
+}
+
+// personsVisibleTo, fotoğrafı izleyiciye gösterilecek kişi bilgisiyle döndürür.
+func personsVisibleTo(viewer string, img *UploadedImage) *UploadedImage {
+	if img.OwnerId != "" && img.OwnerId == viewer {
+		return img
+	}
+	hasPerson := false
+	for _, face := range img.FaceAnalysis {
+		hasPerson = hasPerson || face.PersonId != ""
+	}
+	if !hasPerson {
+		return img
+	}
+	hidden := proto.Clone(img).(*UploadedImage)
+	for _, face := range hidden.FaceAnalysis {
+		face.PersonId = ""
+		face.PersonName = ""
+	}
+	return hidden
+}
+
+// parseFaceRef, yüz başvurusunu doğrular ve fotoğraf ID'sini sayıya çevirir.
+func parseFaceRef(face *FaceRef) (int64, int32, error) {
+	if face == nil {
+		return 0, 0, status.Error(codes.InvalidArgument, "yüz belirtilmelidir")
+	}
+	photoID, err := strconv.ParseInt(face.ImageId, 10, 64)
+	if err != nil {
+		return 0, 0, status.Errorf(codes.InvalidArgument, "geçersiz fotoğraf ID'si: %q", face.ImageId)
+	}
+	if face.FaceIndex < 0 {
+		return 0, 0, status.Error(codes.InvalidArgument, "yüz sırası negatif olamaz")
+	}
+	return photoID, face.FaceIndex, nil
+}
+
+// getPerson, sahibine ait bir kişiyi döndürür. Kişi yoksa veya başka bir sahibe aitse NotFound döner.
+// Satır, işlem bitene kadar kilitlenir.
+func getPerson(tx *sql.Tx, ownerID string, personID int64) (*Person, error) {
+	row := tx.QueryRow(`SELECT `+personColumns+` FROM persons pe
+                        WHERE pe.id = $1 AND pe.owner_id = $2 FOR UPDATE`, personID, ownerID)
+	person, err := scanPerson(row)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, status.Errorf(codes.NotFound, "kişi bulunamadı: %d", personID)
+	}
+	return person, err
+}
+
+// CreatePerson, sahibi için yeni bir kişi oluşturur.
+func (p *PeopleServer) CreatePerson(ctx context.Context, req *CreatePersonRequest) (*Person, error) {
+	ownerID, err := personOwner(ctx)
+	if err != nil {
+		return nil, err
+	}
+	name := strings.TrimSpace(req.Name)
+	if name == "" {
+		return nil, status.Error(codes.InvalidArgument, "kişi adı boş olamaz")
+	}
+	if utf8.RuneCountInString(name) > maxPersonNameLength {
+		return nil, status.Errorf(codes.InvalidArgument, "kişi adı en fazla %d karakter olabilir", maxPersonNameLength)
+	}
+
+	createdAt := now().UTC()
+	person := &Person{OwnerId: ownerID, Name: name, CreatedAt: createdAt.Unix()}
+	err = db.QueryRow(`INSERT INTO persons (owner_id, name, created_at) VALUES ($1, $2, $3) RETURNING id`,
+		ownerID, name, createdAt).Scan(&person.Id)
+	if err != nil {
+		slog.ErrorContext(ctx, "Kişi oluşturulamadı", "error", err)
+		return nil, fmt.Errorf("Kişi oluşturulamadı: %v", err)
+	}
+	return person, nil
+}
+
+// ListPersons, sahibin kişilerini ada göre sıralı listeler.
+func (p *PeopleServer) ListPersons(ctx context.Context, req *ListPersonsRequest) (*ListPersonsResponse, error) {
+	ownerID, err := personOwner(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	pageSize := clampPageSize(req.PageSize)
+	page, err := resolvePage(req.PageToken, 0, pageSize, filterDigest("persons", ownerID))
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := db.Query(`SELECT `+personColumns+` FROM persons pe
+                           WHERE pe.owner_id = $1
+                           ORDER BY lower(pe.name), pe.id
+                           LIMIT $2 OFFSET $3`, ownerID, pageSize+1, page.Offset)
+	if err != nil {
+		slog.ErrorContext(ctx, "Kişiler alınamadı", "error", err)
+		return nil, fmt.Errorf("Kişiler alınamadı: %v", err)
+	}
+	defer rows.Close()
+
+	var persons []*Person
+	for rows.Next() {
+		person, err := scanPerson(rows)
+		if err != nil {
+			return nil, fmt.Errorf("Kişi alınamadı: %v", err)
+		}
+		persons = append(persons, person)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("Kişiler alınamadı: %v", err)
+	}
+
+	hasMore := len(persons) > pageSize
+	if hasMore {
+		persons = persons[:pageSize]
+	}
+	return &ListPersonsResponse{Persons: persons, NextPageToken: nextPageToken(page, pageSize, hasMore)}, nil
+}
+
+// AssignFace, bir fotoğraftaki yüzü kişiye atar. Yüz başka bir kişiye atanmışsa yeni kişiye taşınır.
+func (p *PeopleServer) AssignFace(ctx context.Context, req *AssignFaceRequest) (*Person, error) {
+	ownerID, err := personOwner(ctx)
+	if err != nil {
+		return nil, err
+	}
+	personID, err := parsePersonID(req.PersonId)
+	if err != nil {
+		return nil, err
+	}
+	photoID, faceIndex, err := parseFaceRef(req.Face)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := db.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	if _, err := getPerson(tx, ownerID, personID); err != nil {
+		return nil, err
+	}
+	if err := setFacePerson(ctx, tx, ownerID, photoID, faceIndex, personID); err != nil {
+		return nil, err
+	}
+
+	person, err := getPerson(tx, ownerID, personID)
+	if err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("Yüz kişiye atanamadı: %v", err)
+	}
+	return person, nil
+}
+
+// UnassignFace, bir yüzün kişi atamasını kaldırır.
+func (p *PeopleServer) UnassignFace(ctx context.Context, req *UnassignFaceRequest) (*FaceRef, error) {
+	ownerID, err := personOwner(ctx)
+	if err != nil {
+		return nil, err
+	}
+	photoID, faceIndex, err := parseFaceRef(req.Face)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := db.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	if err := setFacePerson(ctx, tx, ownerID, photoID, faceIndex, nil); err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("Yüz ataması kaldırılamadı: %v", err)
+	}
+	return req.Face, nil
+}
+
+// setFacePerson, sahibin fotoğrafındaki bir yüzün kişisini değiştirir.
+// personID nil ise atama kaldırılır.
+func setFacePerson(ctx context.Context, tx *sql.Tx, ownerID string, photoID int64, faceIndex int32, personID any) error {
+	result, err := tx.ExecContext(ctx, `UPDATE photo_faces f SET person_id = $4
+                            FROM photos p
+                            WHERE f.photo_id = p.id AND f.photo_id = $1 AND f.face_index = $2
+                              AND COALESCE(p.owner_id, '') = $3`,
+		photoID, faceIndex, ownerID, personID)
+	if err != nil {
+		slog.ErrorContext(ctx, "Yüz ataması güncellenemedi", "error", err)
+		return fmt.Errorf("Yüz ataması güncellenemedi: %v", err)
+	}
+	if n, err := result.RowsAffected(); err == nil && n == 0 {
+		return status.Errorf(codes.NotFound, "yüz bulunamadı: fotoğraf %d, yüz %d", photoID, faceIndex)
+	}
+	return nil
+}
+
+// MergePersons, kaynak kişilerin yüzlerini hedef kişiye taşır ve kaynak kişileri siler.
+func (p *PeopleServer) MergePersons(ctx context.Context, req *MergePersonsRequest) (*Person, error) {
+	ownerID, err := personOwner(ctx)
+	if err != nil {
+		return nil, err
+	}
+	targetID, err := parsePersonID(req.TargetPersonId)
+	if err != nil {
+		return nil, err
+	}
+	if len(req.SourcePersonIds) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "en az bir kaynak kişi belirtilmelidir")
+	}
+
+	seen := map[int64]bool{targetID: true}
+	var sourceIDs []int64
+	for _, id := range req.SourcePersonIds {
+		sourceID, err := parsePersonID(id)
+		if err != nil {
+			return nil, err
+		}
+		if sourceID == targetID {
+			return nil, status.Error(codes.InvalidArgument, "hedef kişi kaynak kişiler arasında olamaz")
+		}
+		if !seen[sourceID] {
+			seen[sourceID] = true
+			sourceIDs = append(sourceIDs, sourceID)
+		}
+	}
+
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	for _, id := range append([]int64{targetID}, sourceIDs...) {
+		if _, err := getPerson(tx, ownerID, id); err != nil {
+			return nil, err
+		}
+	}
+
+	if _, err := tx.Exec(`UPDATE photo_faces SET person_id = $1 WHERE person_id = ANY($2)`, targetID, sourceIDs); err != nil {
+		slog.ErrorContext(ctx, "Kişiler birleştirilemedi", "error", err)
+		return nil, fmt.Errorf("Kişiler birleştirilemedi: %v", err)
+	}
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM persons WHERE id = ANY($1)`, sourceIDs); err != nil {
+		slog.ErrorContext(ctx, "Kişiler silinemedi", "error", err)
+		return nil, fmt.Errorf("Kişiler birleştirilemedi: %v", err)
+	}
+
+	person, err := getPerson(tx, ownerID, targetID)
+	if err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("Kişiler birleştirilemedi: %v", err)
+	}
+
+	slog.InfoContext(ctx, "Kişiler birleştirildi", "sources", len(sourceIDs), "target_id", targetID)
+	return person, nil
+}
+
+// ListPersonPhotos, kişinin göründüğü fotoğrafları yeniden eskiye listeler.
+func (p *PeopleServer) ListPersonPhotos(ctx context.Context, req *ListPersonPhotosRequest) (*GetImageFeedResponse, error) {
+	ownerID, err := personOwner(ctx)
+	if err != nil {
+		return nil, err
+	}
+	personID, err := parsePersonID(req.PersonId)
+	if err != nil {
+		return nil, err
+	}
+
+	pageSize := clampPageSize(req.PageSize)
+	page, err := resolvePage(req.PageToken, 0, pageSize, filterDigest("person", ownerID, req.PersonId))
+	if err != nil {
+		return nil, err
+	}
+
+	var exists bool
+	err = db.QueryRow(`SELECT EXISTS (SELECT 1 FROM persons WHERE id = $1 AND owner_id = $2)`,
+		personID, ownerID).Scan(&exists)
+	if err != nil {
+		return nil, fmt.Errorf("Kişi alınamadı: %v", err)
+	}
+	if !exists {
+		return nil, status.Errorf(codes.NotFound, "kişi bulunamadı: %d", personID)
+	}
+
+	rows, err := db.QueryContext(ctx, `SELECT `+photoColumns+` FROM photos p
+                           WHERE moderation_status = 'ACCEPTED' AND upload_time <= $2
+                             AND EXISTS (SELECT 1 FROM photo_faces f WHERE f.photo_id = p.id AND f.person_id = $1)
+                           ORDER BY upload_time DESC, id DESC
+                           LIMIT $3 OFFSET $4`,
+		personID, time.Unix(page.Snapshot, 0).UTC(), pageSize+1, page.Offset)
+	if err != nil {
+		slog.ErrorContext(ctx, "Kişinin fotoğrafları alınamadı", "error", err)
+		return nil, fmt.Errorf("Kişinin fotoğrafları alınamadı: %v", err)
+	}
+	defer rows.Close()
+
+	var images []*UploadedImage
+	for rows.Next() {
+		img, err := scanPhoto(rows)
+		if err != nil {
+			return nil, fmt.Errorf("Fotoğraf alınamadı: %v", err)
+		}
+		images = append(images, img)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("Kişinin fotoğrafları alınamadı: %v", err)
+	}
+
+	hasMore := len(images) > pageSize
+	if hasMore {
+		images = images[:pageSize]
+	}
+	if err := loadImageDetails(ctx, images); err != nil {
+		return nil, fmt.Errorf("Fotoğraf ayrıntıları alınamadı: %v", err)
+	}
+	return &GetImageFeedResponse{Images: images, NextPageToken: nextPageToken(page, pageSize, hasMore)}, nil
+}
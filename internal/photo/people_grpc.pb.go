@@ -0,0 +1,294 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             v4.25.2
+// source: proto/people.proto
+
+package photo
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	PeopleService_CreatePerson_FullMethodName     = "/photo.PeopleService/CreatePerson"
+	PeopleService_ListPersons_FullMethodName      = "/photo.PeopleService/ListPersons"
+	PeopleService_AssignFace_FullMethodName       = "/photo.PeopleService/AssignFace"
+	PeopleService_UnassignFace_FullMethodName     = "/photo.PeopleService/UnassignFace"
+	PeopleService_MergePersons_FullMethodName     = "/photo.PeopleService/MergePersons"
+	PeopleService_ListPersonPhotos_FullMethodName = "/photo.PeopleService/ListPersonPhotos"
+)
+
+// PeopleServiceClient is the client API for PeopleService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type PeopleServiceClient interface {
+	CreatePerson(ctx context.Context, in *CreatePersonRequest, opts ...grpc.CallOption) (*Person, error)
+	ListPersons(ctx context.Context, in *ListPersonsRequest, opts ...grpc.CallOption) (*ListPersonsResponse, error)
+	AssignFace(ctx context.Context, in *AssignFaceRequest, opts ...grpc.CallOption) (*Person, error)
+	UnassignFace(ctx context.Context, in *UnassignFaceRequest, opts ...grpc.CallOption) (*FaceRef, error)
+	MergePersons(ctx context.Context, in *MergePersonsRequest, opts ...grpc.CallOption) (*Person, error)
+	ListPersonPhotos(ctx context.Context, in *ListPersonPhotosRequest, opts ...grpc.CallOption) (*GetImageFeedResponse, error)
+}
+
+type peopleServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPeopleServiceClient(cc grpc.ClientConnInterface) PeopleServiceClient {
+	return &peopleServiceClient{cc}
+}
+
+func (c *peopleServiceClient) CreatePerson(ctx context.Context, in *CreatePersonRequest, opts ...grpc.CallOption) (*Person, error) {
+	out := new(Person)
+	err := c.cc.Invoke(ctx, PeopleService_CreatePerson_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *peopleServiceClient) ListPersons(ctx context.Context, in *ListPersonsRequest, opts ...grpc.CallOption) (*ListPersonsResponse, error) {
+	out := new(ListPersonsResponse)
+	err := c.cc.Invoke(ctx, PeopleService_ListPersons_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *peopleServiceClient) AssignFace(ctx context.Context, in *AssignFaceRequest, opts ...grpc.CallOption) (*Person, error) {
+	out := new(Person)
+	err := c.cc.Invoke(ctx, PeopleService_AssignFace_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *peopleServiceClient) UnassignFace(ctx context.Context, in *UnassignFaceRequest, opts ...grpc.CallOption) (*FaceRef, error) {
+	out := new(FaceRef)
+	err := c.cc.Invoke(ctx, PeopleService_UnassignFace_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *peopleServiceClient) MergePersons(ctx context.Context, in *MergePersonsRequest, opts ...grpc.CallOption) (*Person, error) {
+	out := new(Person)
+	err := c.cc.Invoke(ctx, PeopleService_MergePersons_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *peopleServiceClient) ListPersonPhotos(ctx context.Context, in *ListPersonPhotosRequest, opts ...grpc.CallOption) (*GetImageFeedResponse, error) {
+	out := new(GetImageFeedResponse)
+	err := c.cc.Invoke(ctx, PeopleService_ListPersonPhotos_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PeopleServiceServer is the server API for PeopleService service.
+// All implementations must embed UnimplementedPeopleServiceServer
+// for forward compatibility
+type PeopleServiceServer interface {
+	CreatePerson(context.Context, *CreatePersonRequest) (*Person, error)
+	ListPersons(context.Context, *ListPersonsRequest) (*ListPersonsResponse, error)
+	AssignFace(context.Context, *AssignFaceRequest) (*Person, error)
+	UnassignFace(context.Context, *UnassignFaceRequest) (*FaceRef, error)
+	MergePersons(context.Context, *MergePersonsRequest) (*Person, error)
+	ListPersonPhotos(context.Context, *ListPersonPhotosRequest) (*GetImageFeedResponse, error)
+	mustEmbedUnimplementedPeopleServiceServer()
+}
+
+// UnimplementedPeopleServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedPeopleServiceServer struct {
+}
+
+func (UnimplementedPeopleServiceServer) CreatePerson(context.Context, *CreatePersonRequest) (*Person, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePerson not implemented")
+}
+func (UnimplementedPeopleServiceServer) ListPersons(context.Context, *ListPersonsRequest) (*ListPersonsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPersons not implemented")
+}
+func (UnimplementedPeopleServiceServer) AssignFace(context.Context, *AssignFaceRequest) (*Person, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AssignFace not implemented")
+}
+func (UnimplementedPeopleServiceServer) UnassignFace(context.Context, *UnassignFaceRequest) (*FaceRef, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnassignFace not implemented")
+}
+func (UnimplementedPeopleServiceServer) MergePersons(context.Context, *MergePersonsRequest) (*Person, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MergePersons not implemented")
+}
+func (UnimplementedPeopleServiceServer) ListPersonPhotos(context.Context, *ListPersonPhotosRequest) (*GetImageFeedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPersonPhotos not implemented")
+}
+func (UnimplementedPeopleServiceServer) mustEmbedUnimplementedPeopleServiceServer() {}
+
+// UnsafePeopleServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PeopleServiceServer will
+// result in compilation errors.
+type UnsafePeopleServiceServer interface {
+	mustEmbedUnimplementedPeopleServiceServer()
+}
+
+func RegisterPeopleServiceServer(s grpc.ServiceRegistrar, srv PeopleServiceServer) {
+	s.RegisterService(&PeopleService_ServiceDesc, srv)
+}
+
+func _PeopleService_CreatePerson_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePersonRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PeopleServiceServer).CreatePerson(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PeopleService_CreatePerson_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PeopleServiceServer).CreatePerson(ctx, req.(*CreatePersonRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PeopleService_ListPersons_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPersonsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PeopleServiceServer).ListPersons(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PeopleService_ListPersons_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PeopleServiceServer).ListPersons(ctx, req.(*ListPersonsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PeopleService_AssignFace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AssignFaceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PeopleServiceServer).AssignFace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PeopleService_AssignFace_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PeopleServiceServer).AssignFace(ctx, req.(*AssignFaceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PeopleService_UnassignFace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnassignFaceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PeopleServiceServer).UnassignFace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PeopleService_UnassignFace_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PeopleServiceServer).UnassignFace(ctx, req.(*UnassignFaceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PeopleService_MergePersons_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MergePersonsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PeopleServiceServer).MergePersons(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PeopleService_MergePersons_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PeopleServiceServer).MergePersons(ctx, req.(*MergePersonsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PeopleService_ListPersonPhotos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPersonPhotosRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PeopleServiceServer).ListPersonPhotos(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PeopleService_ListPersonPhotos_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PeopleServiceServer).ListPersonPhotos(ctx, req.(*ListPersonPhotosRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// PeopleService_ServiceDesc is the grpc.ServiceDesc for PeopleService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var PeopleService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "photo.PeopleService",
+	HandlerType: (*PeopleServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreatePerson",
+			Handler:    _PeopleService_CreatePerson_Handler,
+		},
+		{
+			MethodName: "ListPersons",
+			Handler:    _PeopleService_ListPersons_Handler,
+		},
+		{
+			MethodName: "AssignFace",
+			Handler:    _PeopleService_AssignFace_Handler,
+		},
+		{
+			MethodName: "UnassignFace",
+			Handler:    _PeopleService_UnassignFace_Handler,
+		},
+		{
+			MethodName: "MergePersons",
+			Handler:    _PeopleService_MergePersons_Handler,
+		},
+		{
+			MethodName: "ListPersonPhotos",
+			Handler:    _PeopleService_ListPersonPhotos_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/people.proto",
+}
@@ -0,0 +1,94 @@
+package photo
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestPeopleServerRequiresIdentity(t *testing.T) {
+	// Kimliği bilinmeyen çağrılar veritabanına ulaşmadan reddedilir.
+	p := NewPeopleServer()
+	ctx := context.Background()
+
+	if _, err := p.CreatePerson(ctx, &CreatePersonRequest{Name: "Ayşe"}); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("CreatePerson = %v, want PermissionDenied", err)
+	}
+	if _, err := p.ListPersons(ctx, &ListPersonsRequest{}); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("ListPersons = %v, want PermissionDenied", err)
+	}
+	face := &FaceRef{ImageId: "1"}
+	if _, err := p.AssignFace(ctx, &AssignFaceRequest{PersonId: "1", Face: face}); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("AssignFace = %v, want PermissionDenied", err)
+	}
+	if _, err := p.UnassignFace(ctx, &UnassignFaceRequest{Face: face}); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("UnassignFace = %v, want PermissionDenied", err)
+	}
+	merge := &MergePersonsRequest{TargetPersonId: "1", SourcePersonIds: []string{"2"}}
+	if _, err := p.MergePersons(ctx, merge); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("MergePersons = %v, want PermissionDenied", err)
+	}
+	if _, err := p.ListPersonPhotos(ctx, &ListPersonPhotosRequest{PersonId: "1"}); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("ListPersonPhotos = %v, want PermissionDenied", err)
+	}
+}
+
+func TestPeopleServerValidation(t *testing.T) {
+	p := NewPeopleServer()
+	ctx := peerContext("203.0.113.7")
+
+	tests := []struct {
+		name string
+		call func() error
+	}{
+		{"boş ad", func() error { _, err := p.CreatePerson(ctx, &CreatePersonRequest{Name: "  "}); return err }},
+		{"geçersiz kişi ID'si", func() error {
+			_, err := p.AssignFace(ctx, &AssignFaceRequest{PersonId: "abc", Face: &FaceRef{ImageId: "1"}})
+			return err
+		}},
+		{"yüz yok", func() error { _, err := p.AssignFace(ctx, &AssignFaceRequest{PersonId: "1"}); return err }},
+		{"negatif yüz sırası", func() error {
+			_, err := p.UnassignFace(ctx, &UnassignFaceRequest{Face: &FaceRef{ImageId: "1", FaceIndex: -1}})
+			return err
+		}},
+		{"hedef kaynaklarda", func() error {
+			_, err := p.MergePersons(ctx, &MergePersonsRequest{TargetPersonId: "1", SourcePersonIds: []string{"1"}})
+			return err
+		}},
+		{"kaynak yok", func() error { _, err := p.MergePersons(ctx, &MergePersonsRequest{TargetPersonId: "1"}); return err }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if code := status.Code(tt.call()); code != codes.InvalidArgument {
+				t.Errorf("hata kodu = %s, want InvalidArgument", code)
+			}
+		})
+	}
+}
+
+func TestPersonsVisibleTo(t *testing.T) {
+	img := &UploadedImage{
+		Id:           "1",
+		OwnerId:      "203.0.113.7",
+		FaceAnalysis: []*FaceAnalysis{{Emotion: "Joy", PersonId: "5", PersonName: "Ayşe"}},
+	}
+
+	if got := personsVisibleTo("203.0.113.7", img); got != img {
+		t.Errorf("sahip için fotoğraf kopyalanmamalı")
+	}
+
+	hidden := personsVisibleTo("198.51.100.1", img)
+	if face := hidden.FaceAnalysis[0]; face.PersonId != "" || face.PersonName != "" || face.Emotion != "Joy" {
+		t.Errorf("başka istemciye dönen yüz = %v, want kişisiz", face)
+	}
+	if img.FaceAnalysis[0].PersonName != "Ayşe" {
+		t.Errorf("asıl fotoğraf değiştirilmemeli")
+	}
+
+	ownerless := &UploadedImage{FaceAnalysis: []*FaceAnalysis{{PersonId: "5", PersonName: "Ayşe"}}}
+	if got := personsVisibleTo("", ownerless); got.FaceAnalysis[0].PersonName != "" {
+		t.Errorf("sahipsiz fotoğrafta kişi adı gösterilmemeli")
+	}
+}
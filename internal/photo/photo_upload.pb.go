@@ -20,13 +20,490 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// TextDetectionMode, yüklemede hangi OCR özelliğinin isteneceğini belirler.
+type TextDetectionMode int32
+
+const (
+	TextDetectionMode_TEXT_DETECTION_NONE     TextDetectionMode = 0
+	TextDetectionMode_TEXT_DETECTION_SPARSE   TextDetectionMode = 1 // TEXT_DETECTION: tabela, ekran görüntüsü gibi dağınık metin
+	TextDetectionMode_TEXT_DETECTION_DOCUMENT TextDetectionMode = 2 // DOCUMENT_TEXT_DETECTION: yoğun belge metni
+)
+
+// Enum value maps for TextDetectionMode.
+var (
+	TextDetectionMode_name = map[int32]string{
+		0: "TEXT_DETECTION_NONE",
+		1: "TEXT_DETECTION_SPARSE",
+		2: "TEXT_DETECTION_DOCUMENT",
+	}
+	TextDetectionMode_value = map[string]int32{
+		"TEXT_DETECTION_NONE":     0,
+		"TEXT_DETECTION_SPARSE":   1,
+		"TEXT_DETECTION_DOCUMENT": 2,
+	}
+)
+
+func (x TextDetectionMode) Enum() *TextDetectionMode {
+	p := new(TextDetectionMode)
+	*p = x
+	return p
+}
+
+func (x TextDetectionMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TextDetectionMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_photo_upload_proto_enumTypes[0].Descriptor()
+}
+
+func (TextDetectionMode) Type() protoreflect.EnumType {
+	return &file_proto_photo_upload_proto_enumTypes[0]
+}
+
+func (x TextDetectionMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TextDetectionMode.Descriptor instead.
+func (TextDetectionMode) EnumDescriptor() ([]byte, []int) {
+	return file_proto_photo_upload_proto_rawDescGZIP(), []int{0}
+}
+
+// Likelihood, Vision API olasılık derecelerini aynı sayısal değerlerle temsil eder.
+type Likelihood int32
+
+const (
+	Likelihood_LIKELIHOOD_UNKNOWN       Likelihood = 0
+	Likelihood_LIKELIHOOD_VERY_UNLIKELY Likelihood = 1
+	Likelihood_LIKELIHOOD_UNLIKELY      Likelihood = 2
+	Likelihood_LIKELIHOOD_POSSIBLE      Likelihood = 3
+	Likelihood_LIKELIHOOD_LIKELY        Likelihood = 4
+	Likelihood_LIKELIHOOD_VERY_LIKELY   Likelihood = 5
+)
+
+// Enum value maps for Likelihood.
+var (
+	Likelihood_name = map[int32]string{
+		0: "LIKELIHOOD_UNKNOWN",
+		1: "LIKELIHOOD_VERY_UNLIKELY",
+		2: "LIKELIHOOD_UNLIKELY",
+		3: "LIKELIHOOD_POSSIBLE",
+		4: "LIKELIHOOD_LIKELY",
+		5: "LIKELIHOOD_VERY_LIKELY",
+	}
+	Likelihood_value = map[string]int32{
+		"LIKELIHOOD_UNKNOWN":       0,
+		"LIKELIHOOD_VERY_UNLIKELY": 1,
+		"LIKELIHOOD_UNLIKELY":      2,
+		"LIKELIHOOD_POSSIBLE":      3,
+		"LIKELIHOOD_LIKELY":        4,
+		"LIKELIHOOD_VERY_LIKELY":   5,
+	}
+)
+
+func (x Likelihood) Enum() *Likelihood {
+	p := new(Likelihood)
+	*p = x
+	return p
+}
+
+func (x Likelihood) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Likelihood) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_photo_upload_proto_enumTypes[1].Descriptor()
+}
+
+func (Likelihood) Type() protoreflect.EnumType {
+	return &file_proto_photo_upload_proto_enumTypes[1]
+}
+
+func (x Likelihood) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Likelihood.Descriptor instead.
+func (Likelihood) EnumDescriptor() ([]byte, []int) {
+	return file_proto_photo_upload_proto_rawDescGZIP(), []int{1}
+}
+
+// ModerationStatus, bir fotoğrafın içerik denetimi durumudur.
+type ModerationStatus int32
+
+const (
+	ModerationStatus_MODERATION_STATUS_UNSPECIFIED ModerationStatus = 0
+	ModerationStatus_MODERATION_ACCEPTED           ModerationStatus = 1
+	ModerationStatus_MODERATION_QUARANTINED        ModerationStatus = 2 // Akıştan gizlenir, moderatör kararı bekler
+	ModerationStatus_MODERATION_REJECTED           ModerationStatus = 3
+	ModerationStatus_MODERATION_PENDING            ModerationStatus = 4 // Analiz bekleniyor; analiz tamamlanana kadar akışta görünmez
+)
+
+// Enum value maps for ModerationStatus.
+var (
+	ModerationStatus_name = map[int32]string{
+		0: "MODERATION_STATUS_UNSPECIFIED",
+		1: "MODERATION_ACCEPTED",
+		2: "MODERATION_QUARANTINED",
+		3: "MODERATION_REJECTED",
+		4: "MODERATION_PENDING",
+	}
+	ModerationStatus_value = map[string]int32{
+		"MODERATION_STATUS_UNSPECIFIED": 0,
+		"MODERATION_ACCEPTED":           1,
+		"MODERATION_QUARANTINED":        2,
+		"MODERATION_REJECTED":           3,
+		"MODERATION_PENDING":            4,
+	}
+)
+
+func (x ModerationStatus) Enum() *ModerationStatus {
+	p := new(ModerationStatus)
+	*p = x
+	return p
+}
+
+func (x ModerationStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ModerationStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_photo_upload_proto_enumTypes[2].Descriptor()
+}
+
+func (ModerationStatus) Type() protoreflect.EnumType {
+	return &file_proto_photo_upload_proto_enumTypes[2]
+}
+
+func (x ModerationStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ModerationStatus.Descriptor instead.
+func (ModerationStatus) EnumDescriptor() ([]byte, []int) {
+	return file_proto_photo_upload_proto_rawDescGZIP(), []int{2}
+}
+
+// AnalysisStatus, fotoğrafın Vision API analizinin durumudur.
+type AnalysisStatus int32
+
+const (
+	AnalysisStatus_ANALYSIS_COMPLETE AnalysisStatus = 0
+	AnalysisStatus_ANALYSIS_PENDING  AnalysisStatus = 1 // Vision API'ye ulaşılamadığı için kuyruğa alındı; arka planda analiz edilecek
+	AnalysisStatus_ANALYSIS_FAILED   AnalysisStatus = 2 // Kuyruktaki analiz başarısız oldu
+)
+
+// Enum value maps for AnalysisStatus.
+var (
+	AnalysisStatus_name = map[int32]string{
+		0: "ANALYSIS_COMPLETE",
+		1: "ANALYSIS_PENDING",
+		2: "ANALYSIS_FAILED",
+	}
+	AnalysisStatus_value = map[string]int32{
+		"ANALYSIS_COMPLETE": 0,
+		"ANALYSIS_PENDING":  1,
+		"ANALYSIS_FAILED":   2,
+	}
+)
+
+func (x AnalysisStatus) Enum() *AnalysisStatus {
+	p := new(AnalysisStatus)
+	*p = x
+	return p
+}
+
+func (x AnalysisStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AnalysisStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_photo_upload_proto_enumTypes[3].Descriptor()
+}
+
+func (AnalysisStatus) Type() protoreflect.EnumType {
+	return &file_proto_photo_upload_proto_enumTypes[3]
+}
+
+func (x AnalysisStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AnalysisStatus.Descriptor instead.
+func (AnalysisStatus) EnumDescriptor() ([]byte, []int) {
+	return file_proto_photo_upload_proto_rawDescGZIP(), []int{3}
+}
+
+// LocationSource, bir fotoğraf konumunun nereden geldiğini belirtir.
+type LocationSource int32
+
+const (
+	LocationSource_LOCATION_SOURCE_UNSPECIFIED LocationSource = 0
+	LocationSource_LOCATION_SOURCE_CLIENT      LocationSource = 1 // İstemcinin gönderdiği (örneğin EXIF GPS verisinden okuduğu), sunucunun doğrulayamadığı konum
+	LocationSource_LOCATION_SOURCE_LANDMARK    LocationSource = 2 // Vision API LANDMARK_DETECTION koordinatları
+)
+
+// Enum value maps for LocationSource.
+var (
+	LocationSource_name = map[int32]string{
+		0: "LOCATION_SOURCE_UNSPECIFIED",
+		1: "LOCATION_SOURCE_CLIENT",
+		2: "LOCATION_SOURCE_LANDMARK",
+	}
+	LocationSource_value = map[string]int32{
+		"LOCATION_SOURCE_UNSPECIFIED": 0,
+		"LOCATION_SOURCE_CLIENT":      1,
+		"LOCATION_SOURCE_LANDMARK":    2,
+	}
+)
+
+func (x LocationSource) Enum() *LocationSource {
+	p := new(LocationSource)
+	*p = x
+	return p
+}
+
+func (x LocationSource) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (LocationSource) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_photo_upload_proto_enumTypes[4].Descriptor()
+}
+
+func (LocationSource) Type() protoreflect.EnumType {
+	return &file_proto_photo_upload_proto_enumTypes[4]
+}
+
+func (x LocationSource) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use LocationSource.Descriptor instead.
+func (LocationSource) EnumDescriptor() ([]byte, []int) {
+	return file_proto_photo_upload_proto_rawDescGZIP(), []int{4}
+}
+
+// FeedRanking, akışın hangi sıralama stratejisiyle oluşturulacağını belirler.
+type FeedRanking int32
+
+const (
+	FeedRanking_FEED_RANKING_UNSPECIFIED FeedRanking = 0 // Yapılandırmadaki varsayılan strateji
+	FeedRanking_FEED_RANKING_RECENCY     FeedRanking = 1 // Yeniden eskiye
+	FeedRanking_FEED_RANKING_EMOTION     FeedRanking = 2 // Ağırlıklı duygu puanına göre
+	FeedRanking_FEED_RANKING_HOT         FeedRanking = 3 // Duygu puanı ile zamanla azalan yenilik puanının toplamı
+	FeedRanking_FEED_RANKING_RANDOM      FeedRanking = 4 // Tohuma göre karıştırılmış, aynı tohumla her zaman aynı sıra
+)
+
+// Enum value maps for FeedRanking.
+var (
+	FeedRanking_name = map[int32]string{
+		0: "FEED_RANKING_UNSPECIFIED",
+		1: "FEED_RANKING_RECENCY",
+		2: "FEED_RANKING_EMOTION",
+		3: "FEED_RANKING_HOT",
+		4: "FEED_RANKING_RANDOM",
+	}
+	FeedRanking_value = map[string]int32{
+		"FEED_RANKING_UNSPECIFIED": 0,
+		"FEED_RANKING_RECENCY":     1,
+		"FEED_RANKING_EMOTION":     2,
+		"FEED_RANKING_HOT":         3,
+		"FEED_RANKING_RANDOM":      4,
+	}
+)
+
+func (x FeedRanking) Enum() *FeedRanking {
+	p := new(FeedRanking)
+	*p = x
+	return p
+}
+
+func (x FeedRanking) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (FeedRanking) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_photo_upload_proto_enumTypes[5].Descriptor()
+}
+
+func (FeedRanking) Type() protoreflect.EnumType {
+	return &file_proto_photo_upload_proto_enumTypes[5]
+}
+
+func (x FeedRanking) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use FeedRanking.Descriptor instead.
+func (FeedRanking) EnumDescriptor() ([]byte, []int) {
+	return file_proto_photo_upload_proto_rawDescGZIP(), []int{5}
+}
+
+// FeedTimeField, zaman aralığı filtresinin hangi zamana uygulanacağını belirler.
+type FeedTimeField int32
+
+const (
+	FeedTimeField_FEED_TIME_UPLOAD  FeedTimeField = 0
+	FeedTimeField_FEED_TIME_CAPTURE FeedTimeField = 1 // Çekim zamanı olmayan fotoğraflar elenir
+)
+
+// Enum value maps for FeedTimeField.
+var (
+	FeedTimeField_name = map[int32]string{
+		0: "FEED_TIME_UPLOAD",
+		1: "FEED_TIME_CAPTURE",
+	}
+	FeedTimeField_value = map[string]int32{
+		"FEED_TIME_UPLOAD":  0,
+		"FEED_TIME_CAPTURE": 1,
+	}
+)
+
+func (x FeedTimeField) Enum() *FeedTimeField {
+	p := new(FeedTimeField)
+	*p = x
+	return p
+}
+
+func (x FeedTimeField) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (FeedTimeField) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_photo_upload_proto_enumTypes[6].Descriptor()
+}
+
+func (FeedTimeField) Type() protoreflect.EnumType {
+	return &file_proto_photo_upload_proto_enumTypes[6]
+}
+
+func (x FeedTimeField) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use FeedTimeField.Descriptor instead.
+func (FeedTimeField) EnumDescriptor() ([]byte, []int) {
+	return file_proto_photo_upload_proto_rawDescGZIP(), []int{6}
+}
+
+// FacePresence, fotoğrafta yüz olup olmamasına göre filtreler.
+type FacePresence int32
+
+const (
+	FacePresence_FACE_PRESENCE_ANY           FacePresence = 0
+	FacePresence_FACE_PRESENCE_WITH_FACES    FacePresence = 1
+	FacePresence_FACE_PRESENCE_WITHOUT_FACES FacePresence = 2
+)
+
+// Enum value maps for FacePresence.
+var (
+	FacePresence_name = map[int32]string{
+		0: "FACE_PRESENCE_ANY",
+		1: "FACE_PRESENCE_WITH_FACES",
+		2: "FACE_PRESENCE_WITHOUT_FACES",
+	}
+	FacePresence_value = map[string]int32{
+		"FACE_PRESENCE_ANY":           0,
+		"FACE_PRESENCE_WITH_FACES":    1,
+		"FACE_PRESENCE_WITHOUT_FACES": 2,
+	}
+)
+
+func (x FacePresence) Enum() *FacePresence {
+	p := new(FacePresence)
+	*p = x
+	return p
+}
+
+func (x FacePresence) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (FacePresence) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_photo_upload_proto_enumTypes[7].Descriptor()
+}
+
+func (FacePresence) Type() protoreflect.EnumType {
+	return &file_proto_photo_upload_proto_enumTypes[7]
+}
+
+func (x FacePresence) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use FacePresence.Descriptor instead.
+func (FacePresence) EnumDescriptor() ([]byte, []int) {
+	return file_proto_photo_upload_proto_rawDescGZIP(), []int{7}
+}
+
+// FeedEventType, akıştaki bir fotoğrafın nasıl değiştiğini belirtir.
+type FeedEventType int32
+
+const (
+	FeedEventType_FEED_EVENT_UNSPECIFIED FeedEventType = 0
+	FeedEventType_FEED_EVENT_CREATED     FeedEventType = 1
+	FeedEventType_FEED_EVENT_UPDATED     FeedEventType = 2 // Fotoğraf filtreye yeni uymaya başlamış da olabilir (örneğin, moderatör onayı)
+	FeedEventType_FEED_EVENT_REMOVED     FeedEventType = 3 // Silindi, denetime takıldı veya artık filtreye uymuyor; yalnızca image_id doludur
+)
+
+// Enum value maps for FeedEventType.
+var (
+	FeedEventType_name = map[int32]string{
+		0: "FEED_EVENT_UNSPECIFIED",
+		1: "FEED_EVENT_CREATED",
+		2: "FEED_EVENT_UPDATED",
+		3: "FEED_EVENT_REMOVED",
+	}
+	FeedEventType_value = map[string]int32{
+		"FEED_EVENT_UNSPECIFIED": 0,
+		"FEED_EVENT_CREATED":     1,
+		"FEED_EVENT_UPDATED":     2,
+		"FEED_EVENT_REMOVED":     3,
+	}
+)
+
+func (x FeedEventType) Enum() *FeedEventType {
+	p := new(FeedEventType)
+	*p = x
+	return p
+}
+
+func (x FeedEventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (FeedEventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_photo_upload_proto_enumTypes[8].Descriptor()
+}
+
+func (FeedEventType) Type() protoreflect.EnumType {
+	return &file_proto_photo_upload_proto_enumTypes[8]
+}
+
+func (x FeedEventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use FeedEventType.Descriptor instead.
+func (FeedEventType) EnumDescriptor() ([]byte, []int) {
+	return file_proto_photo_upload_proto_rawDescGZIP(), []int{8}
+}
+
 type FaceAnalysis struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Emotion    string  `protobuf:"bytes,1,opt,name=emotion,proto3" json:"emotion,omitempty"`
-	Confidence float32 `protobuf:"fixed32,2,opt,name=confidence,proto3" json:"confidence,omitempty"`
+	Emotion             string          `protobuf:"bytes,1,opt,name=emotion,proto3" json:"emotion,omitempty"`         // Duygu kurallarıyla ya da puanlardan türetilen etiket; hiçbiri uymazsa "Unknown"
+	Confidence          float32         `protobuf:"fixed32,2,opt,name=confidence,proto3" json:"confidence,omitempty"` // Etiketin olasılığı (bkz. LikelihoodScore)
+	Emotions            *EmotionScores  `protobuf:"bytes,3,opt,name=emotions,proto3" json:"emotions,omitempty"`
+	Attributes          *FaceAttributes `protobuf:"bytes,4,opt,name=attributes,proto3" json:"attributes,omitempty"`
+	DetectionConfidence float32         `protobuf:"fixed32,5,opt,name=detection_confidence,json=detectionConfidence,proto3" json:"detection_confidence,omitempty"` // Yüzün bulunduğuna dair Vision API güveni
+	Geometry            *FaceGeometry   `protobuf:"bytes,6,opt,name=geometry,proto3" json:"geometry,omitempty"`                                                    // Görüntü boyutları bulunamadıysa boştur
+	PersonId            string          `protobuf:"bytes,7,opt,name=person_id,json=personId,proto3" json:"person_id,omitempty"`                                    // Yüz bir kişiye atandıysa doludur (bkz. PeopleService)
+	PersonName          string          `protobuf:"bytes,8,opt,name=person_name,json=personName,proto3" json:"person_name,omitempty"`
 }
 
 func (x *FaceAnalysis) Reset() {
@@ -75,19 +552,60 @@ func (x *FaceAnalysis) GetConfidence() float32 {
 	return 0
 }
 
-type UploadedImage struct {
+func (x *FaceAnalysis) GetEmotions() *EmotionScores {
+	if x != nil {
+		return x.Emotions
+	}
+	return nil
+}
+
+func (x *FaceAnalysis) GetAttributes() *FaceAttributes {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+func (x *FaceAnalysis) GetDetectionConfidence() float32 {
+	if x != nil {
+		return x.DetectionConfidence
+	}
+	return 0
+}
+
+func (x *FaceAnalysis) GetGeometry() *FaceGeometry {
+	if x != nil {
+		return x.Geometry
+	}
+	return nil
+}
+
+func (x *FaceAnalysis) GetPersonId() string {
+	if x != nil {
+		return x.PersonId
+	}
+	return ""
+}
+
+func (x *FaceAnalysis) GetPersonName() string {
+	if x != nil {
+		return x.PersonName
+	}
+	return ""
+}
+
+// NormalizedVertex, görüntü genişliği ve yüksekliğine bölünmüş, [0, 1] aralığındaki bir noktadır.
+type NormalizedVertex struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id           string          `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Url          string          `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
-	FaceAnalysis []*FaceAnalysis `protobuf:"bytes,3,rep,name=face_analysis,json=faceAnalysis,proto3" json:"face_analysis,omitempty"`
-	UploadTime   int64           `protobuf:"varint,4,opt,name=upload_time,json=uploadTime,proto3" json:"upload_time,omitempty"` // UploadTime alanını ekledik
+	X float32 `protobuf:"fixed32,1,opt,name=x,proto3" json:"x,omitempty"`
+	Y float32 `protobuf:"fixed32,2,opt,name=y,proto3" json:"y,omitempty"`
 }
 
-func (x *UploadedImage) Reset() {
-	*x = UploadedImage{}
+func (x *NormalizedVertex) Reset() {
+	*x = NormalizedVertex{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_photo_upload_proto_msgTypes[1]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -95,13 +613,13 @@ func (x *UploadedImage) Reset() {
 	}
 }
 
-func (x *UploadedImage) String() string {
+func (x *NormalizedVertex) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UploadedImage) ProtoMessage() {}
+func (*NormalizedVertex) ProtoMessage() {}
 
-func (x *UploadedImage) ProtoReflect() protoreflect.Message {
+func (x *NormalizedVertex) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_photo_upload_proto_msgTypes[1]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -113,50 +631,39 @@ func (x *UploadedImage) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UploadedImage.ProtoReflect.Descriptor instead.
-func (*UploadedImage) Descriptor() ([]byte, []int) {
+// Deprecated: Use NormalizedVertex.ProtoReflect.Descriptor instead.
+func (*NormalizedVertex) Descriptor() ([]byte, []int) {
 	return file_proto_photo_upload_proto_rawDescGZIP(), []int{1}
 }
 
-func (x *UploadedImage) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
-}
-
-func (x *UploadedImage) GetUrl() string {
-	if x != nil {
-		return x.Url
-	}
-	return ""
-}
-
-func (x *UploadedImage) GetFaceAnalysis() []*FaceAnalysis {
+func (x *NormalizedVertex) GetX() float32 {
 	if x != nil {
-		return x.FaceAnalysis
+		return x.X
 	}
-	return nil
+	return 0
 }
 
-func (x *UploadedImage) GetUploadTime() int64 {
+func (x *NormalizedVertex) GetY() float32 {
 	if x != nil {
-		return x.UploadTime
+		return x.Y
 	}
 	return 0
 }
 
-type GetImageFeedRequest struct {
+// FaceLandmark, bir yüz işaret noktasıdır (örneğin, LEFT_EYE, NOSE_TIP).
+type FaceLandmark struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PageNumber int32 `protobuf:"varint,1,opt,name=page_number,json=pageNumber,proto3" json:"page_number,omitempty"`
-	PageSize   int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	Type string  `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	X    float32 `protobuf:"fixed32,2,opt,name=x,proto3" json:"x,omitempty"` // Normalize
+	Y    float32 `protobuf:"fixed32,3,opt,name=y,proto3" json:"y,omitempty"` // Normalize
+	Z    float32 `protobuf:"fixed32,4,opt,name=z,proto3" json:"z,omitempty"` // Görüntü düzlemine göre derinlik, piksel
 }
 
-func (x *GetImageFeedRequest) Reset() {
-	*x = GetImageFeedRequest{}
+func (x *FaceLandmark) Reset() {
+	*x = FaceLandmark{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_photo_upload_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -164,13 +671,13 @@ func (x *GetImageFeedRequest) Reset() {
 	}
 }
 
-func (x *GetImageFeedRequest) String() string {
+func (x *FaceLandmark) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetImageFeedRequest) ProtoMessage() {}
+func (*FaceLandmark) ProtoMessage() {}
 
-func (x *GetImageFeedRequest) ProtoReflect() protoreflect.Message {
+func (x *FaceLandmark) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_photo_upload_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -182,35 +689,55 @@ func (x *GetImageFeedRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetImageFeedRequest.ProtoReflect.Descriptor instead.
-func (*GetImageFeedRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use FaceLandmark.ProtoReflect.Descriptor instead.
+func (*FaceLandmark) Descriptor() ([]byte, []int) {
 	return file_proto_photo_upload_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *GetImageFeedRequest) GetPageNumber() int32 {
+func (x *FaceLandmark) GetType() string {
 	if x != nil {
-		return x.PageNumber
+		return x.Type
+	}
+	return ""
+}
+
+func (x *FaceLandmark) GetX() float32 {
+	if x != nil {
+		return x.X
 	}
 	return 0
 }
 
-func (x *GetImageFeedRequest) GetPageSize() int32 {
+func (x *FaceLandmark) GetY() float32 {
 	if x != nil {
-		return x.PageSize
+		return x.Y
 	}
 	return 0
 }
 
-type GetImageFeedResponse struct {
+func (x *FaceLandmark) GetZ() float32 {
+	if x != nil {
+		return x.Z
+	}
+	return 0
+}
+
+// FaceGeometry, bir yüzün görüntü üzerindeki konumu ve duruşudur.
+type FaceGeometry struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Images []*UploadedImage `protobuf:"bytes,1,rep,name=images,proto3" json:"images,omitempty"`
+	BoundingPoly   []*NormalizedVertex `protobuf:"bytes,1,rep,name=bounding_poly,json=boundingPoly,proto3" json:"bounding_poly,omitempty"`         // Baş dahil, daha geniş kutu
+	FdBoundingPoly []*NormalizedVertex `protobuf:"bytes,2,rep,name=fd_bounding_poly,json=fdBoundingPoly,proto3" json:"fd_bounding_poly,omitempty"` // Yalnızca yüz derisini çevreleyen sıkı kutu
+	Landmarks      []*FaceLandmark     `protobuf:"bytes,3,rep,name=landmarks,proto3" json:"landmarks,omitempty"`
+	RollAngle      float32             `protobuf:"fixed32,4,opt,name=roll_angle,json=rollAngle,proto3" json:"roll_angle,omitempty"` // Derece, [-180, 180]
+	PanAngle       float32             `protobuf:"fixed32,5,opt,name=pan_angle,json=panAngle,proto3" json:"pan_angle,omitempty"`    // Derece, [-180, 180]
+	TiltAngle      float32             `protobuf:"fixed32,6,opt,name=tilt_angle,json=tiltAngle,proto3" json:"tilt_angle,omitempty"` // Derece, [-180, 180]
 }
 
-func (x *GetImageFeedResponse) Reset() {
-	*x = GetImageFeedResponse{}
+func (x *FaceGeometry) Reset() {
+	*x = FaceGeometry{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_photo_upload_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -218,13 +745,13 @@ func (x *GetImageFeedResponse) Reset() {
 	}
 }
 
-func (x *GetImageFeedResponse) String() string {
+func (x *FaceGeometry) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetImageFeedResponse) ProtoMessage() {}
+func (*FaceGeometry) ProtoMessage() {}
 
-func (x *GetImageFeedResponse) ProtoReflect() protoreflect.Message {
+func (x *FaceGeometry) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_photo_upload_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -236,149 +763,3001 @@ func (x *GetImageFeedResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetImageFeedResponse.ProtoReflect.Descriptor instead.
-func (*GetImageFeedResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use FaceGeometry.ProtoReflect.Descriptor instead.
+func (*FaceGeometry) Descriptor() ([]byte, []int) {
 	return file_proto_photo_upload_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *GetImageFeedResponse) GetImages() []*UploadedImage {
+func (x *FaceGeometry) GetBoundingPoly() []*NormalizedVertex {
 	if x != nil {
-		return x.Images
+		return x.BoundingPoly
 	}
 	return nil
 }
 
-var File_proto_photo_upload_proto protoreflect.FileDescriptor
+func (x *FaceGeometry) GetFdBoundingPoly() []*NormalizedVertex {
+	if x != nil {
+		return x.FdBoundingPoly
+	}
+	return nil
+}
 
-var file_proto_photo_upload_proto_rawDesc = []byte{
-	0x0a, 0x18, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x5f, 0x75, 0x70,
-	0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x05, 0x70, 0x68, 0x6f, 0x74,
-	0x6f, 0x22, 0x48, 0x0a, 0x0c, 0x46, 0x61, 0x63, 0x65, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69,
-	0x73, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x07, 0x65, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x63,
-	0x6f, 0x6e, 0x66, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x02, 0x52,
-	0x0a, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x22, 0x8c, 0x01, 0x0a, 0x0d,
-	0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x64, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x0e, 0x0a,
-	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x10, 0x0a,
-	0x03, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12,
-	0x38, 0x0a, 0x0d, 0x66, 0x61, 0x63, 0x65, 0x5f, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69, 0x73,
-	0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x2e, 0x46,
-	0x61, 0x63, 0x65, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69, 0x73, 0x52, 0x0c, 0x66, 0x61, 0x63,
-	0x65, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x75, 0x70, 0x6c,
-	0x6f, 0x61, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a,
-	0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x53, 0x0a, 0x13, 0x47, 0x65,
-	0x74, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x46, 0x65, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x4e, 0x75, 0x6d, 0x62,
-	0x65, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x22,
-	0x44, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x46, 0x65, 0x65, 0x64, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x06, 0x69, 0x6d, 0x61, 0x67, 0x65,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x2e,
-	0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x64, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x06, 0x69,
-	0x6d, 0x61, 0x67, 0x65, 0x73, 0x32, 0x91, 0x02, 0x0a, 0x0c, 0x50, 0x68, 0x6f, 0x74, 0x6f, 0x53,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x39, 0x0a, 0x0b, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64,
-	0x49, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x14, 0x2e, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x70,
-	0x6c, 0x6f, 0x61, 0x64, 0x65, 0x64, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x1a, 0x14, 0x2e, 0x70, 0x68,
-	0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x64, 0x49, 0x6d, 0x61, 0x67,
-	0x65, 0x12, 0x3c, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x44, 0x65, 0x74,
-	0x61, 0x69, 0x6c, 0x12, 0x14, 0x2e, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x70, 0x6c, 0x6f,
-	0x61, 0x64, 0x65, 0x64, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x1a, 0x14, 0x2e, 0x70, 0x68, 0x6f, 0x74,
-	0x6f, 0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x64, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x12,
-	0x47, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x46, 0x65, 0x65, 0x64, 0x12,
-	0x1a, 0x2e, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x6d, 0x61, 0x67, 0x65,
-	0x46, 0x65, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x70, 0x68,
-	0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x46, 0x65, 0x65, 0x64,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3f, 0x0a, 0x11, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x12, 0x14, 0x2e,
-	0x70, 0x68, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x64, 0x49, 0x6d,
-	0x61, 0x67, 0x65, 0x1a, 0x14, 0x2e, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x70, 0x6c, 0x6f,
-	0x61, 0x64, 0x65, 0x64, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x42, 0x1b, 0x5a, 0x19, 0x6d, 0x79, 0x70,
-	0x68, 0x6f, 0x74, 0x6f, 0x61, 0x70, 0x70, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
-	0x2f, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+func (x *FaceGeometry) GetLandmarks() []*FaceLandmark {
+	if x != nil {
+		return x.Landmarks
+	}
+	return nil
 }
 
-var (
-	file_proto_photo_upload_proto_rawDescOnce sync.Once
-	file_proto_photo_upload_proto_rawDescData = file_proto_photo_upload_proto_rawDesc
-)
+func (x *FaceGeometry) GetRollAngle() float32 {
+	if x != nil {
+		return x.RollAngle
+	}
+	return 0
+}
 
-func file_proto_photo_upload_proto_rawDescGZIP() []byte {
-	file_proto_photo_upload_proto_rawDescOnce.Do(func() {
-		file_proto_photo_upload_proto_rawDescData = protoimpl.X.CompressGZIP(file_proto_photo_upload_proto_rawDescData)
-	})
-	return file_proto_photo_upload_proto_rawDescData
+func (x *FaceGeometry) GetPanAngle() float32 {
+	if x != nil {
+		return x.PanAngle
+	}
+	return 0
 }
 
-var file_proto_photo_upload_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
-var file_proto_photo_upload_proto_goTypes = []interface{}{
-	(*FaceAnalysis)(nil),         // 0: photo.FaceAnalysis
-	(*UploadedImage)(nil),        // 1: photo.UploadedImage
-	(*GetImageFeedRequest)(nil),  // 2: photo.GetImageFeedRequest
-	(*GetImageFeedResponse)(nil), // 3: photo.GetImageFeedResponse
+func (x *FaceGeometry) GetTiltAngle() float32 {
+	if x != nil {
+		return x.TiltAngle
+	}
+	return 0
 }
-var file_proto_photo_upload_proto_depIdxs = []int32{
-	0, // 0: photo.UploadedImage.face_analysis:type_name -> photo.FaceAnalysis
-	1, // 1: photo.GetImageFeedResponse.images:type_name -> photo.UploadedImage
-	1, // 2: photo.PhotoService.UploadImage:input_type -> photo.UploadedImage
-	1, // 3: photo.PhotoService.GetImageDetail:input_type -> photo.UploadedImage
-	2, // 4: photo.PhotoService.GetImageFeed:input_type -> photo.GetImageFeedRequest
-	1, // 5: photo.PhotoService.UpdateImageDetail:input_type -> photo.UploadedImage
-	1, // 6: photo.PhotoService.UploadImage:output_type -> photo.UploadedImage
-	1, // 7: photo.PhotoService.GetImageDetail:output_type -> photo.UploadedImage
-	3, // 8: photo.PhotoService.GetImageFeed:output_type -> photo.GetImageFeedResponse
-	1, // 9: photo.PhotoService.UpdateImageDetail:output_type -> photo.UploadedImage
-	6, // [6:10] is the sub-list for method output_type
-	2, // [2:6] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+
+// LikelihoodScore, bir Vision API olasılık derecesini ve ona karşılık gelen olasılığı birlikte taşır.
+// Dönüşüm: UNKNOWN=0, VERY_UNLIKELY=0.05, UNLIKELY=0.25, POSSIBLE=0.5, LIKELY=0.75, VERY_LIKELY=0.95.
+type LikelihoodScore struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Likelihood  Likelihood `protobuf:"varint,1,opt,name=likelihood,proto3,enum=photo.Likelihood" json:"likelihood,omitempty"`
+	Probability float32    `protobuf:"fixed32,2,opt,name=probability,proto3" json:"probability,omitempty"`
 }
 
-func init() { file_proto_photo_upload_proto_init() }
-func file_proto_photo_upload_proto_init() {
-	if File_proto_photo_upload_proto != nil {
-		return
+func (x *LikelihoodScore) Reset() {
+	*x = LikelihoodScore{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_photo_upload_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_proto_photo_upload_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FaceAnalysis); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+}
+
+func (x *LikelihoodScore) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LikelihoodScore) ProtoMessage() {}
+
+func (x *LikelihoodScore) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_photo_upload_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_proto_photo_upload_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UploadedImage); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LikelihoodScore.ProtoReflect.Descriptor instead.
+func (*LikelihoodScore) Descriptor() ([]byte, []int) {
+	return file_proto_photo_upload_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *LikelihoodScore) GetLikelihood() Likelihood {
+	if x != nil {
+		return x.Likelihood
+	}
+	return Likelihood_LIKELIHOOD_UNKNOWN
+}
+
+func (x *LikelihoodScore) GetProbability() float32 {
+	if x != nil {
+		return x.Probability
+	}
+	return 0
+}
+
+// EmotionScores, bir yüz için her duygunun puanıdır.
+type EmotionScores struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Joy      *LikelihoodScore `protobuf:"bytes,1,opt,name=joy,proto3" json:"joy,omitempty"`
+	Sorrow   *LikelihoodScore `protobuf:"bytes,2,opt,name=sorrow,proto3" json:"sorrow,omitempty"`
+	Anger    *LikelihoodScore `protobuf:"bytes,3,opt,name=anger,proto3" json:"anger,omitempty"`
+	Surprise *LikelihoodScore `protobuf:"bytes,4,opt,name=surprise,proto3" json:"surprise,omitempty"`
+}
+
+func (x *EmotionScores) Reset() {
+	*x = EmotionScores{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_photo_upload_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EmotionScores) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EmotionScores) ProtoMessage() {}
+
+func (x *EmotionScores) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_photo_upload_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_proto_photo_upload_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetImageFeedRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EmotionScores.ProtoReflect.Descriptor instead.
+func (*EmotionScores) Descriptor() ([]byte, []int) {
+	return file_proto_photo_upload_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *EmotionScores) GetJoy() *LikelihoodScore {
+	if x != nil {
+		return x.Joy
+	}
+	return nil
+}
+
+func (x *EmotionScores) GetSorrow() *LikelihoodScore {
+	if x != nil {
+		return x.Sorrow
+	}
+	return nil
+}
+
+func (x *EmotionScores) GetAnger() *LikelihoodScore {
+	if x != nil {
+		return x.Anger
+	}
+	return nil
+}
+
+func (x *EmotionScores) GetSurprise() *LikelihoodScore {
+	if x != nil {
+		return x.Surprise
+	}
+	return nil
+}
+
+// FaceAttributes, yüzün görüntü kalitesi ve aksesuar puanlarıdır.
+type FaceAttributes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Blurred      *LikelihoodScore `protobuf:"bytes,1,opt,name=blurred,proto3" json:"blurred,omitempty"`
+	Headwear     *LikelihoodScore `protobuf:"bytes,2,opt,name=headwear,proto3" json:"headwear,omitempty"`
+	UnderExposed *LikelihoodScore `protobuf:"bytes,3,opt,name=under_exposed,json=underExposed,proto3" json:"under_exposed,omitempty"`
+}
+
+func (x *FaceAttributes) Reset() {
+	*x = FaceAttributes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_photo_upload_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FaceAttributes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FaceAttributes) ProtoMessage() {}
+
+func (x *FaceAttributes) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_photo_upload_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_proto_photo_upload_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetImageFeedResponse); i {
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FaceAttributes.ProtoReflect.Descriptor instead.
+func (*FaceAttributes) Descriptor() ([]byte, []int) {
+	return file_proto_photo_upload_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *FaceAttributes) GetBlurred() *LikelihoodScore {
+	if x != nil {
+		return x.Blurred
+	}
+	return nil
+}
+
+func (x *FaceAttributes) GetHeadwear() *LikelihoodScore {
+	if x != nil {
+		return x.Headwear
+	}
+	return nil
+}
+
+func (x *FaceAttributes) GetUnderExposed() *LikelihoodScore {
+	if x != nil {
+		return x.UnderExposed
+	}
+	return nil
+}
+
+// Label, Vision API etiket tespitinin bir sonucunu temsil eder.
+type Label struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Description string  `protobuf:"bytes,1,opt,name=description,proto3" json:"description,omitempty"`
+	Score       float32 `protobuf:"fixed32,2,opt,name=score,proto3" json:"score,omitempty"`
+	Mid         string  `protobuf:"bytes,3,opt,name=mid,proto3" json:"mid,omitempty"`
+}
+
+func (x *Label) Reset() {
+	*x = Label{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_photo_upload_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Label) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Label) ProtoMessage() {}
+
+func (x *Label) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_photo_upload_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Label.ProtoReflect.Descriptor instead.
+func (*Label) Descriptor() ([]byte, []int) {
+	return file_proto_photo_upload_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *Label) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Label) GetScore() float32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *Label) GetMid() string {
+	if x != nil {
+		return x.Mid
+	}
+	return ""
+}
+
+// SafeSearch, Vision API SAFE_SEARCH_DETECTION sonucudur.
+type SafeSearch struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Adult    Likelihood `protobuf:"varint,1,opt,name=adult,proto3,enum=photo.Likelihood" json:"adult,omitempty"`
+	Violence Likelihood `protobuf:"varint,2,opt,name=violence,proto3,enum=photo.Likelihood" json:"violence,omitempty"`
+	Racy     Likelihood `protobuf:"varint,3,opt,name=racy,proto3,enum=photo.Likelihood" json:"racy,omitempty"`
+	Medical  Likelihood `protobuf:"varint,4,opt,name=medical,proto3,enum=photo.Likelihood" json:"medical,omitempty"`
+	Spoof    Likelihood `protobuf:"varint,5,opt,name=spoof,proto3,enum=photo.Likelihood" json:"spoof,omitempty"`
+}
+
+func (x *SafeSearch) Reset() {
+	*x = SafeSearch{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_photo_upload_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SafeSearch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SafeSearch) ProtoMessage() {}
+
+func (x *SafeSearch) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_photo_upload_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SafeSearch.ProtoReflect.Descriptor instead.
+func (*SafeSearch) Descriptor() ([]byte, []int) {
+	return file_proto_photo_upload_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *SafeSearch) GetAdult() Likelihood {
+	if x != nil {
+		return x.Adult
+	}
+	return Likelihood_LIKELIHOOD_UNKNOWN
+}
+
+func (x *SafeSearch) GetViolence() Likelihood {
+	if x != nil {
+		return x.Violence
+	}
+	return Likelihood_LIKELIHOOD_UNKNOWN
+}
+
+func (x *SafeSearch) GetRacy() Likelihood {
+	if x != nil {
+		return x.Racy
+	}
+	return Likelihood_LIKELIHOOD_UNKNOWN
+}
+
+func (x *SafeSearch) GetMedical() Likelihood {
+	if x != nil {
+		return x.Medical
+	}
+	return Likelihood_LIKELIHOOD_UNKNOWN
+}
+
+func (x *SafeSearch) GetSpoof() Likelihood {
+	if x != nil {
+		return x.Spoof
+	}
+	return Likelihood_LIKELIHOOD_UNKNOWN
+}
+
+// Location, bir fotoğrafın WGS84 koordinatlarını tutar.
+type Location struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Latitude  float64        `protobuf:"fixed64,1,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude float64        `protobuf:"fixed64,2,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	Source    LocationSource `protobuf:"varint,3,opt,name=source,proto3,enum=photo.LocationSource" json:"source,omitempty"`
+	Name      string         `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"` // Yer işareti adı (yalnızca LANDMARK kaynağında)
+}
+
+func (x *Location) Reset() {
+	*x = Location{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_photo_upload_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Location) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Location) ProtoMessage() {}
+
+func (x *Location) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_photo_upload_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Location.ProtoReflect.Descriptor instead.
+func (*Location) Descriptor() ([]byte, []int) {
+	return file_proto_photo_upload_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *Location) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *Location) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+func (x *Location) GetSource() LocationSource {
+	if x != nil {
+		return x.Source
+	}
+	return LocationSource_LOCATION_SOURCE_UNSPECIFIED
+}
+
+func (x *Location) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// Vertex, görüntü üzerindeki bir noktayı piksel cinsinden temsil eder.
+type Vertex struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	X int32 `protobuf:"varint,1,opt,name=x,proto3" json:"x,omitempty"`
+	Y int32 `protobuf:"varint,2,opt,name=y,proto3" json:"y,omitempty"`
+}
+
+func (x *Vertex) Reset() {
+	*x = Vertex{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_photo_upload_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Vertex) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Vertex) ProtoMessage() {}
+
+func (x *Vertex) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_photo_upload_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Vertex.ProtoReflect.Descriptor instead.
+func (*Vertex) Descriptor() ([]byte, []int) {
+	return file_proto_photo_upload_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *Vertex) GetX() int32 {
+	if x != nil {
+		return x.X
+	}
+	return 0
+}
+
+func (x *Vertex) GetY() int32 {
+	if x != nil {
+		return x.Y
+	}
+	return 0
+}
+
+// TextBlock, OCR ile tespit edilen bir metin bloğudur.
+type TextBlock struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Text        string    `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+	Confidence  float32   `protobuf:"fixed32,2,opt,name=confidence,proto3" json:"confidence,omitempty"`
+	BoundingBox []*Vertex `protobuf:"bytes,3,rep,name=bounding_box,json=boundingBox,proto3" json:"bounding_box,omitempty"`
+}
+
+func (x *TextBlock) Reset() {
+	*x = TextBlock{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_photo_upload_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TextBlock) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TextBlock) ProtoMessage() {}
+
+func (x *TextBlock) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_photo_upload_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TextBlock.ProtoReflect.Descriptor instead.
+func (*TextBlock) Descriptor() ([]byte, []int) {
+	return file_proto_photo_upload_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *TextBlock) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *TextBlock) GetConfidence() float32 {
+	if x != nil {
+		return x.Confidence
+	}
+	return 0
+}
+
+func (x *TextBlock) GetBoundingBox() []*Vertex {
+	if x != nil {
+		return x.BoundingBox
+	}
+	return nil
+}
+
+// DetectedText, bir fotoğraftaki OCR sonuçlarını tutar.
+type DetectedText struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FullText string       `protobuf:"bytes,1,opt,name=full_text,json=fullText,proto3" json:"full_text,omitempty"`
+	Language string       `protobuf:"bytes,2,opt,name=language,proto3" json:"language,omitempty"`
+	Blocks   []*TextBlock `protobuf:"bytes,3,rep,name=blocks,proto3" json:"blocks,omitempty"`
+}
+
+func (x *DetectedText) Reset() {
+	*x = DetectedText{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_photo_upload_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DetectedText) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DetectedText) ProtoMessage() {}
+
+func (x *DetectedText) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_photo_upload_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DetectedText.ProtoReflect.Descriptor instead.
+func (*DetectedText) Descriptor() ([]byte, []int) {
+	return file_proto_photo_upload_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *DetectedText) GetFullText() string {
+	if x != nil {
+		return x.FullText
+	}
+	return ""
+}
+
+func (x *DetectedText) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+func (x *DetectedText) GetBlocks() []*TextBlock {
+	if x != nil {
+		return x.Blocks
+	}
+	return nil
+}
+
+type UploadedImage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id                  string            `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Url                 string            `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	FaceAnalysis        []*FaceAnalysis   `protobuf:"bytes,3,rep,name=face_analysis,json=faceAnalysis,proto3" json:"face_analysis,omitempty"`
+	UploadTime          int64             `protobuf:"varint,4,opt,name=upload_time,json=uploadTime,proto3" json:"upload_time,omitempty"` // UploadTime alanını ekledik
+	Labels              []*Label          `protobuf:"bytes,5,rep,name=labels,proto3" json:"labels,omitempty"`
+	Tags                []string          `protobuf:"bytes,6,rep,name=tags,proto3" json:"tags,omitempty"`                                      // Kullanıcının elle eklediği etiketler
+	DetectLabels        bool              `protobuf:"varint,7,opt,name=detect_labels,json=detectLabels,proto3" json:"detect_labels,omitempty"` // Yükleme sırasında etiket tespiti istenir
+	OwnerId             string            `protobuf:"bytes,8,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`                 // Yükleyenin sunucunun doğruladığı kimliği; istekte gönderilen değer yok sayılır
+	Caption             string            `protobuf:"bytes,9,opt,name=caption,proto3" json:"caption,omitempty"`
+	Text                *DetectedText     `protobuf:"bytes,10,opt,name=text,proto3" json:"text,omitempty"` // Akış ve arama yanıtlarında doldurulmaz; GetImageDetail ile alınır
+	DetectText          TextDetectionMode `protobuf:"varint,11,opt,name=detect_text,json=detectText,proto3,enum=photo.TextDetectionMode" json:"detect_text,omitempty"`
+	ModerationStatus    ModerationStatus  `protobuf:"varint,12,opt,name=moderation_status,json=moderationStatus,proto3,enum=photo.ModerationStatus" json:"moderation_status,omitempty"`
+	SafeSearch          *SafeSearch       `protobuf:"bytes,13,opt,name=safe_search,json=safeSearch,proto3" json:"safe_search,omitempty"`
+	ModerationReason    string            `protobuf:"bytes,14,opt,name=moderation_reason,json=moderationReason,proto3" json:"moderation_reason,omitempty"`
+	Location            *Location         `protobuf:"bytes,15,opt,name=location,proto3" json:"location,omitempty"`                                                    // Yüklemede istemci EXIF GPS konumunu gönderebilir; kaynağı CLIENT olarak kaydedilir
+	DetectLandmarks     bool              `protobuf:"varint,16,opt,name=detect_landmarks,json=detectLandmarks,proto3" json:"detect_landmarks,omitempty"`              // Konum gönderilmediyse yer işareti tespitiyle bulunur
+	EmotionRulesVersion string            `protobuf:"bytes,17,opt,name=emotion_rules_version,json=emotionRulesVersion,proto3" json:"emotion_rules_version,omitempty"` // Duygu etiketlerini üreten kural setinin sürümü
+	ImageWidth          int32             `protobuf:"varint,18,opt,name=image_width,json=imageWidth,proto3" json:"image_width,omitempty"`                             // Piksel; bilinmiyorsa 0
+	ImageHeight         int32             `protobuf:"varint,19,opt,name=image_height,json=imageHeight,proto3" json:"image_height,omitempty"`                          // Piksel; bilinmiyorsa 0
+	CapturedAt          int64             `protobuf:"varint,20,opt,name=captured_at,json=capturedAt,proto3" json:"captured_at,omitempty"`                             // Unix saniye; istemcinin EXIF DateTimeOriginal değerinden okuduğu çekim zamanı
+	AlbumId             string            `protobuf:"bytes,21,opt,name=album_id,json=albumId,proto3" json:"album_id,omitempty"`                                       // İstemcinin fotoğrafı gruplamak için verdiği albüm kimliği
+	Refresh             bool              `protobuf:"varint,22,opt,name=refresh,proto3" json:"refresh,omitempty"`                                                     // İstekte: kayıtlı veya önbellekteki analizi kullanmadan Vision API ile yeniden analiz eder
+	AnalysisStatus      AnalysisStatus    `protobuf:"varint,23,opt,name=analysis_status,json=analysisStatus,proto3,enum=photo.AnalysisStatus" json:"analysis_status,omitempty"`
+}
+
+func (x *UploadedImage) Reset() {
+	*x = UploadedImage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_photo_upload_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UploadedImage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadedImage) ProtoMessage() {}
+
+func (x *UploadedImage) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_photo_upload_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadedImage.ProtoReflect.Descriptor instead.
+func (*UploadedImage) Descriptor() ([]byte, []int) {
+	return file_proto_photo_upload_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *UploadedImage) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UploadedImage) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *UploadedImage) GetFaceAnalysis() []*FaceAnalysis {
+	if x != nil {
+		return x.FaceAnalysis
+	}
+	return nil
+}
+
+func (x *UploadedImage) GetUploadTime() int64 {
+	if x != nil {
+		return x.UploadTime
+	}
+	return 0
+}
+
+func (x *UploadedImage) GetLabels() []*Label {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *UploadedImage) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *UploadedImage) GetDetectLabels() bool {
+	if x != nil {
+		return x.DetectLabels
+	}
+	return false
+}
+
+func (x *UploadedImage) GetOwnerId() string {
+	if x != nil {
+		return x.OwnerId
+	}
+	return ""
+}
+
+func (x *UploadedImage) GetCaption() string {
+	if x != nil {
+		return x.Caption
+	}
+	return ""
+}
+
+func (x *UploadedImage) GetText() *DetectedText {
+	if x != nil {
+		return x.Text
+	}
+	return nil
+}
+
+func (x *UploadedImage) GetDetectText() TextDetectionMode {
+	if x != nil {
+		return x.DetectText
+	}
+	return TextDetectionMode_TEXT_DETECTION_NONE
+}
+
+func (x *UploadedImage) GetModerationStatus() ModerationStatus {
+	if x != nil {
+		return x.ModerationStatus
+	}
+	return ModerationStatus_MODERATION_STATUS_UNSPECIFIED
+}
+
+func (x *UploadedImage) GetSafeSearch() *SafeSearch {
+	if x != nil {
+		return x.SafeSearch
+	}
+	return nil
+}
+
+func (x *UploadedImage) GetModerationReason() string {
+	if x != nil {
+		return x.ModerationReason
+	}
+	return ""
+}
+
+func (x *UploadedImage) GetLocation() *Location {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+func (x *UploadedImage) GetDetectLandmarks() bool {
+	if x != nil {
+		return x.DetectLandmarks
+	}
+	return false
+}
+
+func (x *UploadedImage) GetEmotionRulesVersion() string {
+	if x != nil {
+		return x.EmotionRulesVersion
+	}
+	return ""
+}
+
+func (x *UploadedImage) GetImageWidth() int32 {
+	if x != nil {
+		return x.ImageWidth
+	}
+	return 0
+}
+
+func (x *UploadedImage) GetImageHeight() int32 {
+	if x != nil {
+		return x.ImageHeight
+	}
+	return 0
+}
+
+func (x *UploadedImage) GetCapturedAt() int64 {
+	if x != nil {
+		return x.CapturedAt
+	}
+	return 0
+}
+
+func (x *UploadedImage) GetAlbumId() string {
+	if x != nil {
+		return x.AlbumId
+	}
+	return ""
+}
+
+func (x *UploadedImage) GetRefresh() bool {
+	if x != nil {
+		return x.Refresh
+	}
+	return false
+}
+
+func (x *UploadedImage) GetAnalysisStatus() AnalysisStatus {
+	if x != nil {
+		return x.AnalysisStatus
+	}
+	return AnalysisStatus_ANALYSIS_COMPLETE
+}
+
+type GetImageFeedRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PageNumber int32       `protobuf:"varint,1,opt,name=page_number,json=pageNumber,proto3" json:"page_number,omitempty"`
+	PageSize   int32       `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken  string      `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"` // Doluysa page_number yerine kullanılır
+	Ranking    FeedRanking `protobuf:"varint,4,opt,name=ranking,proto3,enum=photo.FeedRanking" json:"ranking,omitempty"`
+	RandomSeed int64       `protobuf:"varint,5,opt,name=random_seed,json=randomSeed,proto3" json:"random_seed,omitempty"` // FEED_RANKING_RANDOM için; 0 ise sunucu üretir ve sayfa belirtecine yazar
+	Filter     *FeedFilter `protobuf:"bytes,6,opt,name=filter,proto3" json:"filter,omitempty"`
+}
+
+func (x *GetImageFeedRequest) Reset() {
+	*x = GetImageFeedRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_photo_upload_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetImageFeedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetImageFeedRequest) ProtoMessage() {}
+
+func (x *GetImageFeedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_photo_upload_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetImageFeedRequest.ProtoReflect.Descriptor instead.
+func (*GetImageFeedRequest) Descriptor() ([]byte, []int) {
+	return file_proto_photo_upload_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GetImageFeedRequest) GetPageNumber() int32 {
+	if x != nil {
+		return x.PageNumber
+	}
+	return 0
+}
+
+func (x *GetImageFeedRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetImageFeedRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *GetImageFeedRequest) GetRanking() FeedRanking {
+	if x != nil {
+		return x.Ranking
+	}
+	return FeedRanking_FEED_RANKING_UNSPECIFIED
+}
+
+func (x *GetImageFeedRequest) GetRandomSeed() int64 {
+	if x != nil {
+		return x.RandomSeed
+	}
+	return 0
+}
+
+func (x *GetImageFeedRequest) GetFilter() *FeedFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+// FeedFilter, akışı daraltan koşullardır. Boş alanlar uygulanmaz; dolu alanların tümü birlikte sağlanmalıdır.
+type FeedFilter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Emotions      []string      `protobuf:"bytes,1,rep,name=emotions,proto3" json:"emotions,omitempty"`                                  // Bu duygulardan birini taşıyan en az bir yüz
+	MinConfidence float32       `protobuf:"fixed32,2,opt,name=min_confidence,json=minConfidence,proto3" json:"min_confidence,omitempty"` // O yüzün güvenilirliği en az bu değer olmalıdır, [0, 1]
+	TimeAfter     int64         `protobuf:"varint,3,opt,name=time_after,json=timeAfter,proto3" json:"time_after,omitempty"`              // Unix saniye, dahil
+	TimeBefore    int64         `protobuf:"varint,4,opt,name=time_before,json=timeBefore,proto3" json:"time_before,omitempty"`           // Unix saniye, hariç
+	TimeField     FeedTimeField `protobuf:"varint,5,opt,name=time_field,json=timeField,proto3,enum=photo.FeedTimeField" json:"time_field,omitempty"`
+	MinFaces      int32         `protobuf:"varint,6,opt,name=min_faces,json=minFaces,proto3" json:"min_faces,omitempty"`
+	MaxFaces      int32         `protobuf:"varint,7,opt,name=max_faces,json=maxFaces,proto3" json:"max_faces,omitempty"` // 0 ise üst sınır yoktur
+	FacePresence  FacePresence  `protobuf:"varint,8,opt,name=face_presence,json=facePresence,proto3,enum=photo.FacePresence" json:"face_presence,omitempty"`
+}
+
+func (x *FeedFilter) Reset() {
+	*x = FeedFilter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_photo_upload_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FeedFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FeedFilter) ProtoMessage() {}
+
+func (x *FeedFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_photo_upload_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FeedFilter.ProtoReflect.Descriptor instead.
+func (*FeedFilter) Descriptor() ([]byte, []int) {
+	return file_proto_photo_upload_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *FeedFilter) GetEmotions() []string {
+	if x != nil {
+		return x.Emotions
+	}
+	return nil
+}
+
+func (x *FeedFilter) GetMinConfidence() float32 {
+	if x != nil {
+		return x.MinConfidence
+	}
+	return 0
+}
+
+func (x *FeedFilter) GetTimeAfter() int64 {
+	if x != nil {
+		return x.TimeAfter
+	}
+	return 0
+}
+
+func (x *FeedFilter) GetTimeBefore() int64 {
+	if x != nil {
+		return x.TimeBefore
+	}
+	return 0
+}
+
+func (x *FeedFilter) GetTimeField() FeedTimeField {
+	if x != nil {
+		return x.TimeField
+	}
+	return FeedTimeField_FEED_TIME_UPLOAD
+}
+
+func (x *FeedFilter) GetMinFaces() int32 {
+	if x != nil {
+		return x.MinFaces
+	}
+	return 0
+}
+
+func (x *FeedFilter) GetMaxFaces() int32 {
+	if x != nil {
+		return x.MaxFaces
+	}
+	return 0
+}
+
+func (x *FeedFilter) GetFacePresence() FacePresence {
+	if x != nil {
+		return x.FacePresence
+	}
+	return FacePresence_FACE_PRESENCE_ANY
+}
+
+type GetImageFeedResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Images        []*UploadedImage `protobuf:"bytes,1,rep,name=images,proto3" json:"images,omitempty"`
+	NextPageToken string           `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // Son sayfada boştur
+}
+
+func (x *GetImageFeedResponse) Reset() {
+	*x = GetImageFeedResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_photo_upload_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetImageFeedResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetImageFeedResponse) ProtoMessage() {}
+
+func (x *GetImageFeedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_photo_upload_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetImageFeedResponse.ProtoReflect.Descriptor instead.
+func (*GetImageFeedResponse) Descriptor() ([]byte, []int) {
+	return file_proto_photo_upload_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *GetImageFeedResponse) GetImages() []*UploadedImage {
+	if x != nil {
+		return x.Images
+	}
+	return nil
+}
+
+func (x *GetImageFeedResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type WatchFeedRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Filter      *FeedFilter `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	ResumeToken string      `protobuf:"bytes,2,opt,name=resume_token,json=resumeToken,proto3" json:"resume_token,omitempty"` // Boşsa yalnızca bağlantıdan sonraki değişiklikler gönderilir
+}
+
+func (x *WatchFeedRequest) Reset() {
+	*x = WatchFeedRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_photo_upload_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatchFeedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchFeedRequest) ProtoMessage() {}
+
+func (x *WatchFeedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_photo_upload_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchFeedRequest.ProtoReflect.Descriptor instead.
+func (*WatchFeedRequest) Descriptor() ([]byte, []int) {
+	return file_proto_photo_upload_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *WatchFeedRequest) GetFilter() *FeedFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *WatchFeedRequest) GetResumeToken() string {
+	if x != nil {
+		return x.ResumeToken
+	}
+	return ""
+}
+
+// FeedEvent, WatchFeed akışının bir mesajıdır. image, olayın gönderildiği andaki güncel halidir.
+type FeedEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type        FeedEventType  `protobuf:"varint,1,opt,name=type,proto3,enum=photo.FeedEventType" json:"type,omitempty"`
+	ImageId     string         `protobuf:"bytes,2,opt,name=image_id,json=imageId,proto3" json:"image_id,omitempty"`
+	Image       *UploadedImage `protobuf:"bytes,3,opt,name=image,proto3" json:"image,omitempty"`
+	OccurredAt  int64          `protobuf:"varint,4,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`   // Unix saniye
+	ResumeToken string         `protobuf:"bytes,5,opt,name=resume_token,json=resumeToken,proto3" json:"resume_token,omitempty"` // Yeniden bağlanırken bu olaydan sonrasını almak için gönderilir
+}
+
+func (x *FeedEvent) Reset() {
+	*x = FeedEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_photo_upload_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FeedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FeedEvent) ProtoMessage() {}
+
+func (x *FeedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_photo_upload_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FeedEvent.ProtoReflect.Descriptor instead.
+func (*FeedEvent) Descriptor() ([]byte, []int) {
+	return file_proto_photo_upload_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *FeedEvent) GetType() FeedEventType {
+	if x != nil {
+		return x.Type
+	}
+	return FeedEventType_FEED_EVENT_UNSPECIFIED
+}
+
+func (x *FeedEvent) GetImageId() string {
+	if x != nil {
+		return x.ImageId
+	}
+	return ""
+}
+
+func (x *FeedEvent) GetImage() *UploadedImage {
+	if x != nil {
+		return x.Image
+	}
+	return nil
+}
+
+func (x *FeedEvent) GetOccurredAt() int64 {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return 0
+}
+
+func (x *FeedEvent) GetResumeToken() string {
+	if x != nil {
+		return x.ResumeToken
+	}
+	return ""
+}
+
+type BatchUploadImagesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Images []*UploadedImage `protobuf:"bytes,1,rep,name=images,proto3" json:"images,omitempty"` // Her biri UploadImage isteğiyle aynı alanları taşır
+}
+
+func (x *BatchUploadImagesRequest) Reset() {
+	*x = BatchUploadImagesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_photo_upload_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchUploadImagesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchUploadImagesRequest) ProtoMessage() {}
+
+func (x *BatchUploadImagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_photo_upload_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchUploadImagesRequest.ProtoReflect.Descriptor instead.
+func (*BatchUploadImagesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_photo_upload_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *BatchUploadImagesRequest) GetImages() []*UploadedImage {
+	if x != nil {
+		return x.Images
+	}
+	return nil
+}
+
+// BatchUploadResult, toplu yüklemedeki bir fotoğrafın sonucudur.
+type BatchUploadResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code    int32          `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"` // gRPC durum kodu; 0 (OK) ise image doludur
+	Message string         `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Image   *UploadedImage `protobuf:"bytes,3,opt,name=image,proto3" json:"image,omitempty"`
+}
+
+func (x *BatchUploadResult) Reset() {
+	*x = BatchUploadResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_photo_upload_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchUploadResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchUploadResult) ProtoMessage() {}
+
+func (x *BatchUploadResult) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_photo_upload_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchUploadResult.ProtoReflect.Descriptor instead.
+func (*BatchUploadResult) Descriptor() ([]byte, []int) {
+	return file_proto_photo_upload_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *BatchUploadResult) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *BatchUploadResult) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *BatchUploadResult) GetImage() *UploadedImage {
+	if x != nil {
+		return x.Image
+	}
+	return nil
+}
+
+type BatchUploadImagesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Results   []*BatchUploadResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"` // İstekteki sırayla
+	Succeeded int32                `protobuf:"varint,2,opt,name=succeeded,proto3" json:"succeeded,omitempty"`
+	Failed    int32                `protobuf:"varint,3,opt,name=failed,proto3" json:"failed,omitempty"`
+}
+
+func (x *BatchUploadImagesResponse) Reset() {
+	*x = BatchUploadImagesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_photo_upload_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchUploadImagesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchUploadImagesResponse) ProtoMessage() {}
+
+func (x *BatchUploadImagesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_photo_upload_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchUploadImagesResponse.ProtoReflect.Descriptor instead.
+func (*BatchUploadImagesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_photo_upload_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *BatchUploadImagesResponse) GetResults() []*BatchUploadResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *BatchUploadImagesResponse) GetSucceeded() int32 {
+	if x != nil {
+		return x.Succeeded
+	}
+	return 0
+}
+
+func (x *BatchUploadImagesResponse) GetFailed() int32 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+type ImageTagsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ImageId string   `protobuf:"bytes,1,opt,name=image_id,json=imageId,proto3" json:"image_id,omitempty"`
+	Tags    []string `protobuf:"bytes,2,rep,name=tags,proto3" json:"tags,omitempty"`
+}
+
+func (x *ImageTagsRequest) Reset() {
+	*x = ImageTagsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_photo_upload_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImageTagsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImageTagsRequest) ProtoMessage() {}
+
+func (x *ImageTagsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_photo_upload_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImageTagsRequest.ProtoReflect.Descriptor instead.
+func (*ImageTagsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_photo_upload_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ImageTagsRequest) GetImageId() string {
+	if x != nil {
+		return x.ImageId
+	}
+	return ""
+}
+
+func (x *ImageTagsRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type ListImagesByTagRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tag        string `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
+	PageNumber int32  `protobuf:"varint,2,opt,name=page_number,json=pageNumber,proto3" json:"page_number,omitempty"`
+	PageSize   int32  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+}
+
+func (x *ListImagesByTagRequest) Reset() {
+	*x = ListImagesByTagRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_photo_upload_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListImagesByTagRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListImagesByTagRequest) ProtoMessage() {}
+
+func (x *ListImagesByTagRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_photo_upload_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListImagesByTagRequest.ProtoReflect.Descriptor instead.
+func (*ListImagesByTagRequest) Descriptor() ([]byte, []int) {
+	return file_proto_photo_upload_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ListImagesByTagRequest) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *ListImagesByTagRequest) GetPageNumber() int32 {
+	if x != nil {
+		return x.PageNumber
+	}
+	return 0
+}
+
+func (x *ListImagesByTagRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type SearchImagesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Query          string   `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"` // Etiketler, OCR metni, açıklama ve kullanıcı etiketleri üzerinde aranır
+	Emotions       []string `protobuf:"bytes,2,rep,name=emotions,proto3" json:"emotions,omitempty"`
+	UploadedAfter  int64    `protobuf:"varint,3,opt,name=uploaded_after,json=uploadedAfter,proto3" json:"uploaded_after,omitempty"`    // Unix saniye, dahil
+	UploadedBefore int64    `protobuf:"varint,4,opt,name=uploaded_before,json=uploadedBefore,proto3" json:"uploaded_before,omitempty"` // Unix saniye, hariç
+	OwnerId        string   `protobuf:"bytes,5,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	PageSize       int32    `protobuf:"varint,6,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken      string   `protobuf:"bytes,7,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+}
+
+func (x *SearchImagesRequest) Reset() {
+	*x = SearchImagesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_photo_upload_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchImagesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchImagesRequest) ProtoMessage() {}
+
+func (x *SearchImagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_photo_upload_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchImagesRequest.ProtoReflect.Descriptor instead.
+func (*SearchImagesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_photo_upload_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *SearchImagesRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchImagesRequest) GetEmotions() []string {
+	if x != nil {
+		return x.Emotions
+	}
+	return nil
+}
+
+func (x *SearchImagesRequest) GetUploadedAfter() int64 {
+	if x != nil {
+		return x.UploadedAfter
+	}
+	return 0
+}
+
+func (x *SearchImagesRequest) GetUploadedBefore() int64 {
+	if x != nil {
+		return x.UploadedBefore
+	}
+	return 0
+}
+
+func (x *SearchImagesRequest) GetOwnerId() string {
+	if x != nil {
+		return x.OwnerId
+	}
+	return ""
+}
+
+func (x *SearchImagesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *SearchImagesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type SearchResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Image     *UploadedImage `protobuf:"bytes,1,opt,name=image,proto3" json:"image,omitempty"`
+	Rank      float32        `protobuf:"fixed32,2,opt,name=rank,proto3" json:"rank,omitempty"`
+	Highlight string         `protobuf:"bytes,3,opt,name=highlight,proto3" json:"highlight,omitempty"` // Eşleşen kelimeler <mark></mark> ile işaretlenir
+}
+
+func (x *SearchResult) Reset() {
+	*x = SearchResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_photo_upload_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchResult) ProtoMessage() {}
+
+func (x *SearchResult) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_photo_upload_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchResult.ProtoReflect.Descriptor instead.
+func (*SearchResult) Descriptor() ([]byte, []int) {
+	return file_proto_photo_upload_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *SearchResult) GetImage() *UploadedImage {
+	if x != nil {
+		return x.Image
+	}
+	return nil
+}
+
+func (x *SearchResult) GetRank() float32 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *SearchResult) GetHighlight() string {
+	if x != nil {
+		return x.Highlight
+	}
+	return ""
+}
+
+type SearchImagesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Results       []*SearchResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	NextPageToken string          `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+}
+
+func (x *SearchImagesResponse) Reset() {
+	*x = SearchImagesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_photo_upload_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchImagesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchImagesResponse) ProtoMessage() {}
+
+func (x *SearchImagesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_photo_upload_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchImagesResponse.ProtoReflect.Descriptor instead.
+func (*SearchImagesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_photo_upload_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *SearchImagesResponse) GetResults() []*SearchResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *SearchImagesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type SearchImagesNearRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Latitude     float64 `protobuf:"fixed64,1,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude    float64 `protobuf:"fixed64,2,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	RadiusMeters float64 `protobuf:"fixed64,3,opt,name=radius_meters,json=radiusMeters,proto3" json:"radius_meters,omitempty"`
+	PageSize     int32   `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken    string  `protobuf:"bytes,5,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+}
+
+func (x *SearchImagesNearRequest) Reset() {
+	*x = SearchImagesNearRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_photo_upload_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchImagesNearRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchImagesNearRequest) ProtoMessage() {}
+
+func (x *SearchImagesNearRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_photo_upload_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchImagesNearRequest.ProtoReflect.Descriptor instead.
+func (*SearchImagesNearRequest) Descriptor() ([]byte, []int) {
+	return file_proto_photo_upload_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *SearchImagesNearRequest) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *SearchImagesNearRequest) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+func (x *SearchImagesNearRequest) GetRadiusMeters() float64 {
+	if x != nil {
+		return x.RadiusMeters
+	}
+	return 0
+}
+
+func (x *SearchImagesNearRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *SearchImagesNearRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+// min_longitude > max_longitude ise kutu 180. meridyeni geçer.
+type SearchImagesInBoxRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MinLatitude  float64 `protobuf:"fixed64,1,opt,name=min_latitude,json=minLatitude,proto3" json:"min_latitude,omitempty"`
+	MinLongitude float64 `protobuf:"fixed64,2,opt,name=min_longitude,json=minLongitude,proto3" json:"min_longitude,omitempty"`
+	MaxLatitude  float64 `protobuf:"fixed64,3,opt,name=max_latitude,json=maxLatitude,proto3" json:"max_latitude,omitempty"`
+	MaxLongitude float64 `protobuf:"fixed64,4,opt,name=max_longitude,json=maxLongitude,proto3" json:"max_longitude,omitempty"`
+	PageSize     int32   `protobuf:"varint,5,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken    string  `protobuf:"bytes,6,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+}
+
+func (x *SearchImagesInBoxRequest) Reset() {
+	*x = SearchImagesInBoxRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_photo_upload_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchImagesInBoxRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchImagesInBoxRequest) ProtoMessage() {}
+
+func (x *SearchImagesInBoxRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_photo_upload_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchImagesInBoxRequest.ProtoReflect.Descriptor instead.
+func (*SearchImagesInBoxRequest) Descriptor() ([]byte, []int) {
+	return file_proto_photo_upload_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *SearchImagesInBoxRequest) GetMinLatitude() float64 {
+	if x != nil {
+		return x.MinLatitude
+	}
+	return 0
+}
+
+func (x *SearchImagesInBoxRequest) GetMinLongitude() float64 {
+	if x != nil {
+		return x.MinLongitude
+	}
+	return 0
+}
+
+func (x *SearchImagesInBoxRequest) GetMaxLatitude() float64 {
+	if x != nil {
+		return x.MaxLatitude
+	}
+	return 0
+}
+
+func (x *SearchImagesInBoxRequest) GetMaxLongitude() float64 {
+	if x != nil {
+		return x.MaxLongitude
+	}
+	return 0
+}
+
+func (x *SearchImagesInBoxRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *SearchImagesInBoxRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type GeoSearchResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Image          *UploadedImage `protobuf:"bytes,1,opt,name=image,proto3" json:"image,omitempty"`
+	DistanceMeters float64        `protobuf:"fixed64,2,opt,name=distance_meters,json=distanceMeters,proto3" json:"distance_meters,omitempty"` // Yalnızca SearchImagesNear yanıtında doldurulur
+}
+
+func (x *GeoSearchResult) Reset() {
+	*x = GeoSearchResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_photo_upload_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GeoSearchResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GeoSearchResult) ProtoMessage() {}
+
+func (x *GeoSearchResult) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_photo_upload_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GeoSearchResult.ProtoReflect.Descriptor instead.
+func (*GeoSearchResult) Descriptor() ([]byte, []int) {
+	return file_proto_photo_upload_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *GeoSearchResult) GetImage() *UploadedImage {
+	if x != nil {
+		return x.Image
+	}
+	return nil
+}
+
+func (x *GeoSearchResult) GetDistanceMeters() float64 {
+	if x != nil {
+		return x.DistanceMeters
+	}
+	return 0
+}
+
+type GeoSearchResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Results       []*GeoSearchResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	NextPageToken string             `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+}
+
+func (x *GeoSearchResponse) Reset() {
+	*x = GeoSearchResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_photo_upload_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GeoSearchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GeoSearchResponse) ProtoMessage() {}
+
+func (x *GeoSearchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_photo_upload_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GeoSearchResponse.ProtoReflect.Descriptor instead.
+func (*GeoSearchResponse) Descriptor() ([]byte, []int) {
+	return file_proto_photo_upload_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *GeoSearchResponse) GetResults() []*GeoSearchResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *GeoSearchResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+var File_proto_photo_upload_proto protoreflect.FileDescriptor
+
+var file_proto_photo_upload_proto_rawDesc = []byte{
+	0x0a, 0x18, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x5f, 0x75, 0x70,
+	0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x05, 0x70, 0x68, 0x6f, 0x74,
+	0x6f, 0x22, 0xd3, 0x02, 0x0a, 0x0c, 0x46, 0x61, 0x63, 0x65, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x73,
+	0x69, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x65, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x02,
+	0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x30, 0x0a, 0x08,
+	0x65, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14,
+	0x2e, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x63,
+	0x6f, 0x72, 0x65, 0x73, 0x52, 0x08, 0x65, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x35,
+	0x0a, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x2e, 0x46, 0x61, 0x63, 0x65, 0x41,
+	0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x52, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69,
+	0x62, 0x75, 0x74, 0x65, 0x73, 0x12, 0x31, 0x0a, 0x14, 0x64, 0x65, 0x74, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x02, 0x52, 0x13, 0x64, 0x65, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x2f, 0x0a, 0x08, 0x67, 0x65, 0x6f, 0x6d,
+	0x65, 0x74, 0x72, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x68, 0x6f,
+	0x74, 0x6f, 0x2e, 0x46, 0x61, 0x63, 0x65, 0x47, 0x65, 0x6f, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x52,
+	0x08, 0x67, 0x65, 0x6f, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x65, 0x72,
+	0x73, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x65,
+	0x72, 0x73, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x65, 0x72,
+	0x73, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x2e, 0x0a, 0x10, 0x4e, 0x6f, 0x72, 0x6d, 0x61,
+	0x6c, 0x69, 0x7a, 0x65, 0x64, 0x56, 0x65, 0x72, 0x74, 0x65, 0x78, 0x12, 0x0c, 0x0a, 0x01, 0x78,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x02, 0x52, 0x01, 0x78, 0x12, 0x0c, 0x0a, 0x01, 0x79, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x02, 0x52, 0x01, 0x79, 0x22, 0x4c, 0x0a, 0x0c, 0x46, 0x61, 0x63, 0x65, 0x4c,
+	0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x0c, 0x0a, 0x01, 0x78,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x02, 0x52, 0x01, 0x78, 0x12, 0x0c, 0x0a, 0x01, 0x79, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x02, 0x52, 0x01, 0x79, 0x12, 0x0c, 0x0a, 0x01, 0x7a, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x02, 0x52, 0x01, 0x7a, 0x22, 0x9d, 0x02, 0x0a, 0x0c, 0x46, 0x61, 0x63, 0x65, 0x47, 0x65,
+	0x6f, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x12, 0x3c, 0x0a, 0x0d, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x5f, 0x70, 0x6f, 0x6c, 0x79, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e,
+	0x70, 0x68, 0x6f, 0x74, 0x6f, 0x2e, 0x4e, 0x6f, 0x72, 0x6d, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64,
+	0x56, 0x65, 0x72, 0x74, 0x65, 0x78, 0x52, 0x0c, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67,
+	0x50, 0x6f, 0x6c, 0x79, 0x12, 0x41, 0x0a, 0x10, 0x66, 0x64, 0x5f, 0x62, 0x6f, 0x75, 0x6e, 0x64,
+	0x69, 0x6e, 0x67, 0x5f, 0x70, 0x6f, 0x6c, 0x79, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17,
+	0x2e, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x2e, 0x4e, 0x6f, 0x72, 0x6d, 0x61, 0x6c, 0x69, 0x7a, 0x65,
+	0x64, 0x56, 0x65, 0x72, 0x74, 0x65, 0x78, 0x52, 0x0e, 0x66, 0x64, 0x42, 0x6f, 0x75, 0x6e, 0x64,
+	0x69, 0x6e, 0x67, 0x50, 0x6f, 0x6c, 0x79, 0x12, 0x31, 0x0a, 0x09, 0x6c, 0x61, 0x6e, 0x64, 0x6d,
+	0x61, 0x72, 0x6b, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x68, 0x6f,
+	0x74, 0x6f, 0x2e, 0x46, 0x61, 0x63, 0x65, 0x4c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x52,
+	0x09, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x6f,
+	0x6c, 0x6c, 0x5f, 0x61, 0x6e, 0x67, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x02, 0x52, 0x09,
+	0x72, 0x6f, 0x6c, 0x6c, 0x41, 0x6e, 0x67, 0x6c, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x6e,
+	0x5f, 0x61, 0x6e, 0x67, 0x6c, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x02, 0x52, 0x08, 0x70, 0x61,
+	0x6e, 0x41, 0x6e, 0x67, 0x6c, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x69, 0x6c, 0x74, 0x5f, 0x61,
+	0x6e, 0x67, 0x6c, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x02, 0x52, 0x09, 0x74, 0x69, 0x6c, 0x74,
+	0x41, 0x6e, 0x67, 0x6c, 0x65, 0x22, 0x66, 0x0a, 0x0f, 0x4c, 0x69, 0x6b, 0x65, 0x6c, 0x69, 0x68,
+	0x6f, 0x6f, 0x64, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x31, 0x0a, 0x0a, 0x6c, 0x69, 0x6b, 0x65,
+	0x6c, 0x69, 0x68, 0x6f, 0x6f, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x70,
+	0x68, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x69, 0x6b, 0x65, 0x6c, 0x69, 0x68, 0x6f, 0x6f, 0x64, 0x52,
+	0x0a, 0x6c, 0x69, 0x6b, 0x65, 0x6c, 0x69, 0x68, 0x6f, 0x6f, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x70,
+	0x72, 0x6f, 0x62, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x02,
+	0x52, 0x0b, 0x70, 0x72, 0x6f, 0x62, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x22, 0xcb, 0x01,
+	0x0a, 0x0d, 0x45, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x12,
+	0x28, 0x0a, 0x03, 0x6a, 0x6f, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70,
+	0x68, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x69, 0x6b, 0x65, 0x6c, 0x69, 0x68, 0x6f, 0x6f, 0x64, 0x53,
+	0x63, 0x6f, 0x72, 0x65, 0x52, 0x03, 0x6a, 0x6f, 0x79, 0x12, 0x2e, 0x0a, 0x06, 0x73, 0x6f, 0x72,
+	0x72, 0x6f, 0x77, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x68, 0x6f, 0x74,
+	0x6f, 0x2e, 0x4c, 0x69, 0x6b, 0x65, 0x6c, 0x69, 0x68, 0x6f, 0x6f, 0x64, 0x53, 0x63, 0x6f, 0x72,
+	0x65, 0x52, 0x06, 0x73, 0x6f, 0x72, 0x72, 0x6f, 0x77, 0x12, 0x2c, 0x0a, 0x05, 0x61, 0x6e, 0x67,
+	0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x68, 0x6f, 0x74, 0x6f,
+	0x2e, 0x4c, 0x69, 0x6b, 0x65, 0x6c, 0x69, 0x68, 0x6f, 0x6f, 0x64, 0x53, 0x63, 0x6f, 0x72, 0x65,
+	0x52, 0x05, 0x61, 0x6e, 0x67, 0x65, 0x72, 0x12, 0x32, 0x0a, 0x08, 0x73, 0x75, 0x72, 0x70, 0x72,
+	0x69, 0x73, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x68, 0x6f, 0x74,
+	0x6f, 0x2e, 0x4c, 0x69, 0x6b, 0x65, 0x6c, 0x69, 0x68, 0x6f, 0x6f, 0x64, 0x53, 0x63, 0x6f, 0x72,
+	0x65, 0x52, 0x08, 0x73, 0x75, 0x72, 0x70, 0x72, 0x69, 0x73, 0x65, 0x22, 0xb3, 0x01, 0x0a, 0x0e,
+	0x46, 0x61, 0x63, 0x65, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x12, 0x30,
+	0x0a, 0x07, 0x62, 0x6c, 0x75, 0x72, 0x72, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x16, 0x2e, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x69, 0x6b, 0x65, 0x6c, 0x69, 0x68, 0x6f,
+	0x6f, 0x64, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x52, 0x07, 0x62, 0x6c, 0x75, 0x72, 0x72, 0x65, 0x64,
+	0x12, 0x32, 0x0a, 0x08, 0x68, 0x65, 0x61, 0x64, 0x77, 0x65, 0x61, 0x72, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x69, 0x6b, 0x65, 0x6c,
+	0x69, 0x68, 0x6f, 0x6f, 0x64, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x52, 0x08, 0x68, 0x65, 0x61, 0x64,
+	0x77, 0x65, 0x61, 0x72, 0x12, 0x3b, 0x0a, 0x0d, 0x75, 0x6e, 0x64, 0x65, 0x72, 0x5f, 0x65, 0x78,
+	0x70, 0x6f, 0x73, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x68,
+	0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x69, 0x6b, 0x65, 0x6c, 0x69, 0x68, 0x6f, 0x6f, 0x64, 0x53, 0x63,
+	0x6f, 0x72, 0x65, 0x52, 0x0c, 0x75, 0x6e, 0x64, 0x65, 0x72, 0x45, 0x78, 0x70, 0x6f, 0x73, 0x65,
+	0x64, 0x22, 0x51, 0x0a, 0x05, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05,
+	0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x02, 0x52, 0x05, 0x73, 0x63, 0x6f,
+	0x72, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6d, 0x69, 0x64, 0x22, 0xe1, 0x01, 0x0a, 0x0a, 0x53, 0x61, 0x66, 0x65, 0x53, 0x65, 0x61,
+	0x72, 0x63, 0x68, 0x12, 0x27, 0x0a, 0x05, 0x61, 0x64, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x11, 0x2e, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x69, 0x6b, 0x65, 0x6c,
+	0x69, 0x68, 0x6f, 0x6f, 0x64, 0x52, 0x05, 0x61, 0x64, 0x75, 0x6c, 0x74, 0x12, 0x2d, 0x0a, 0x08,
+	0x76, 0x69, 0x6f, 0x6c, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11,
+	0x2e, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x69, 0x6b, 0x65, 0x6c, 0x69, 0x68, 0x6f, 0x6f,
+	0x64, 0x52, 0x08, 0x76, 0x69, 0x6f, 0x6c, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x25, 0x0a, 0x04, 0x72,
+	0x61, 0x63, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x70, 0x68, 0x6f, 0x74,
+	0x6f, 0x2e, 0x4c, 0x69, 0x6b, 0x65, 0x6c, 0x69, 0x68, 0x6f, 0x6f, 0x64, 0x52, 0x04, 0x72, 0x61,
+	0x63, 0x79, 0x12, 0x2b, 0x0a, 0x07, 0x6d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x6c, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x69, 0x6b, 0x65,
+	0x6c, 0x69, 0x68, 0x6f, 0x6f, 0x64, 0x52, 0x07, 0x6d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x6c, 0x12,
+	0x27, 0x0a, 0x05, 0x73, 0x70, 0x6f, 0x6f, 0x66, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11,
+	0x2e, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x69, 0x6b, 0x65, 0x6c, 0x69, 0x68, 0x6f, 0x6f,
+	0x64, 0x52, 0x05, 0x73, 0x70, 0x6f, 0x6f, 0x66, 0x22, 0x87, 0x01, 0x0a, 0x08, 0x4c, 0x6f, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64,
+	0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x12,
+	0x2d, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x15, 0x2e, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x22, 0x24, 0x0a, 0x06, 0x56, 0x65, 0x72, 0x74, 0x65, 0x78, 0x12, 0x0c, 0x0a, 0x01,
+	0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x01, 0x78, 0x12, 0x0c, 0x0a, 0x01, 0x79, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x01, 0x79, 0x22, 0x71, 0x0a, 0x09, 0x54, 0x65, 0x78, 0x74,
+	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0a, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x30, 0x0a, 0x0c, 0x62, 0x6f, 0x75,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x62, 0x6f, 0x78, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x0d, 0x2e, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x2e, 0x56, 0x65, 0x72, 0x74, 0x65, 0x78, 0x52, 0x0b,
+	0x62, 0x6f, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x42, 0x6f, 0x78, 0x22, 0x71, 0x0a, 0x0c, 0x44,
+	0x65, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x54, 0x65, 0x78, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x66,
+	0x75, 0x6c, 0x6c, 0x5f, 0x74, 0x65, 0x78, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x66, 0x75, 0x6c, 0x6c, 0x54, 0x65, 0x78, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x6e, 0x67,
+	0x75, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x61, 0x6e, 0x67,
+	0x75, 0x61, 0x67, 0x65, 0x12, 0x28, 0x0a, 0x06, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x2e, 0x54, 0x65, 0x78,
+	0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x06, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x22, 0x91,
+	0x07, 0x0a, 0x0d, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x64, 0x49, 0x6d, 0x61, 0x67, 0x65,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75,
+	0x72, 0x6c, 0x12, 0x38, 0x0a, 0x0d, 0x66, 0x61, 0x63, 0x65, 0x5f, 0x61, 0x6e, 0x61, 0x6c, 0x79,
+	0x73, 0x69, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x68, 0x6f, 0x74,
+	0x6f, 0x2e, 0x46, 0x61, 0x63, 0x65, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69, 0x73, 0x52, 0x0c,
+	0x66, 0x61, 0x63, 0x65, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69, 0x73, 0x12, 0x1f, 0x0a, 0x0b,
+	0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0a, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x24, 0x0a,
+	0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e,
+	0x70, 0x68, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x52, 0x06, 0x6c, 0x61, 0x62,
+	0x65, 0x6c, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x64, 0x65, 0x74, 0x65, 0x63,
+	0x74, 0x5f, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c,
+	0x64, 0x65, 0x74, 0x65, 0x63, 0x74, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x12, 0x19, 0x0a, 0x08,
+	0x6f, 0x77, 0x6e, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x6f, 0x77, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x61, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x61, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x27, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x13, 0x2e, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64,
+	0x54, 0x65, 0x78, 0x74, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x12, 0x39, 0x0a, 0x0b, 0x64, 0x65,
+	0x74, 0x65, 0x63, 0x74, 0x5f, 0x74, 0x65, 0x78, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x18, 0x2e, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x2e, 0x54, 0x65, 0x78, 0x74, 0x44, 0x65, 0x74, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x0a, 0x64, 0x65, 0x74, 0x65, 0x63,
+	0x74, 0x54, 0x65, 0x78, 0x74, 0x12, 0x44, 0x0a, 0x11, 0x6d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x17, 0x2e, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x10, 0x6d, 0x6f, 0x64, 0x65, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x32, 0x0a, 0x0b, 0x73,
+	0x61, 0x66, 0x65, 0x5f, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x11, 0x2e, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x61, 0x66, 0x65, 0x53, 0x65, 0x61,
+	0x72, 0x63, 0x68, 0x52, 0x0a, 0x73, 0x61, 0x66, 0x65, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x12,
+	0x2b, 0x0a, 0x11, 0x6d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x6d, 0x6f, 0x64, 0x65,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x2b, 0x0a, 0x08,
+	0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f,
+	0x2e, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x29, 0x0a, 0x10, 0x64, 0x65, 0x74,
+	0x65, 0x63, 0x74, 0x5f, 0x6c, 0x61, 0x6e, 0x64, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x18, 0x10, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0f, 0x64, 0x65, 0x74, 0x65, 0x63, 0x74, 0x4c, 0x61, 0x6e, 0x64, 0x6d,
+	0x61, 0x72, 0x6b, 0x73, 0x12, 0x32, 0x0a, 0x15, 0x65, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x72, 0x75, 0x6c, 0x65, 0x73, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x11, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x13, 0x65, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x75, 0x6c, 0x65,
+	0x73, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6d, 0x61, 0x67,
+	0x65, 0x5f, 0x77, 0x69, 0x64, 0x74, 0x68, 0x18, 0x12, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x69,
+	0x6d, 0x61, 0x67, 0x65, 0x57, 0x69, 0x64, 0x74, 0x68, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x6d, 0x61,
+	0x67, 0x65, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x13, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0b, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1f, 0x0a, 0x0b,
+	0x63, 0x61, 0x70, 0x74, 0x75, 0x72, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x14, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0a, 0x63, 0x61, 0x70, 0x74, 0x75, 0x72, 0x65, 0x64, 0x41, 0x74, 0x12, 0x19, 0x0a,
+	0x08, 0x61, 0x6c, 0x62, 0x75, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x15, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x61, 0x6c, 0x62, 0x75, 0x6d, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x66, 0x72,
+	0x65, 0x73, 0x68, 0x18, 0x16, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x72, 0x65, 0x66, 0x72, 0x65,
+	0x73, 0x68, 0x12, 0x3e, 0x0a, 0x0f, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69, 0x73, 0x5f, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x17, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x70, 0x68,
+	0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69, 0x73, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x52, 0x0e, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69, 0x73, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x22, 0xec, 0x01, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x46,
+	0x65, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x61,
+	0x67, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0a, 0x70, 0x61, 0x67, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x70,
+	0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08,
+	0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65,
+	0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61,
+	0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x2c, 0x0a, 0x07, 0x72, 0x61, 0x6e, 0x6b, 0x69,
+	0x6e, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x70, 0x68, 0x6f, 0x74, 0x6f,
+	0x2e, 0x46, 0x65, 0x65, 0x64, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x72, 0x61,
+	0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x5f,
+	0x73, 0x65, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x72, 0x61, 0x6e, 0x64,
+	0x6f, 0x6d, 0x53, 0x65, 0x65, 0x64, 0x12, 0x29, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x2e, 0x46,
+	0x65, 0x65, 0x64, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x22, 0xb8, 0x02, 0x0a, 0x0a, 0x46, 0x65, 0x65, 0x64, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x12, 0x1a, 0x0a, 0x08, 0x65, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x08, 0x65, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x25, 0x0a, 0x0e,
+	0x6d, 0x69, 0x6e, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x02, 0x52, 0x0d, 0x6d, 0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x64, 0x65,
+	0x6e, 0x63, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x61, 0x66, 0x74, 0x65,
+	0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x41, 0x66, 0x74,
+	0x65, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x74, 0x69, 0x6d, 0x65, 0x42, 0x65, 0x66,
+	0x6f, 0x72, 0x65, 0x12, 0x33, 0x0a, 0x0a, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x66, 0x69, 0x65, 0x6c,
+	0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x2e,
+	0x46, 0x65, 0x65, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x09, 0x74,
+	0x69, 0x6d, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x69, 0x6e, 0x5f,
+	0x66, 0x61, 0x63, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x6d, 0x69, 0x6e,
+	0x46, 0x61, 0x63, 0x65, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f, 0x66, 0x61, 0x63,
+	0x65, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x46, 0x61, 0x63,
+	0x65, 0x73, 0x12, 0x38, 0x0a, 0x0d, 0x66, 0x61, 0x63, 0x65, 0x5f, 0x70, 0x72, 0x65, 0x73, 0x65,
+	0x6e, 0x63, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x70, 0x68, 0x6f, 0x74,
+	0x6f, 0x2e, 0x46, 0x61, 0x63, 0x65, 0x50, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x0c,
+	0x66, 0x61, 0x63, 0x65, 0x50, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x22, 0x6c, 0x0a, 0x14,
+	0x47, 0x65, 0x74, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x46, 0x65, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x06, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x70, 0x6c,
+	0x6f, 0x61, 0x64, 0x65, 0x64, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x06, 0x69, 0x6d, 0x61, 0x67,
+	0x65, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78,
+	0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x60, 0x0a, 0x10, 0x57, 0x61,
+	0x74, 0x63, 0x68, 0x46, 0x65, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x29,
+	0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11,
+	0x2e, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x2e, 0x46, 0x65, 0x65, 0x64, 0x46, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x73,
+	0x75, 0x6d, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0xc0, 0x01, 0x0a,
+	0x09, 0x46, 0x65, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x28, 0x0a, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x70, 0x68, 0x6f, 0x74, 0x6f,
+	0x2e, 0x46, 0x65, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x49, 0x64, 0x12,
+	0x2a, 0x0a, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14,
+	0x2e, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x64, 0x49,
+	0x6d, 0x61, 0x67, 0x65, 0x52, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x6f,
+	0x63, 0x63, 0x75, 0x72, 0x72, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0a, 0x6f, 0x63, 0x63, 0x75, 0x72, 0x72, 0x65, 0x64, 0x41, 0x74, 0x12, 0x21, 0x0a, 0x0c,
+	0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22,
+	0x48, 0x0a, 0x18, 0x42, 0x61, 0x74, 0x63, 0x68, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x49, 0x6d,
+	0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x06, 0x69,
+	0x6d, 0x61, 0x67, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x68,
+	0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x64, 0x49, 0x6d, 0x61, 0x67,
+	0x65, 0x52, 0x06, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x22, 0x6d, 0x0a, 0x11, 0x42, 0x61, 0x74,
+	0x63, 0x68, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x12,
+	0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x63, 0x6f,
+	0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x2a, 0x0a, 0x05,
+	0x69, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x68,
+	0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x64, 0x49, 0x6d, 0x61, 0x67,
+	0x65, 0x52, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x22, 0x85, 0x01, 0x0a, 0x19, 0x42, 0x61, 0x74,
+	0x63, 0x68, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x2e,
+	0x42, 0x61, 0x74, 0x63, 0x68, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x75,
+	0x63, 0x63, 0x65, 0x65, 0x64, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x73,
+	0x75, 0x63, 0x63, 0x65, 0x65, 0x64, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x61, 0x69, 0x6c,
+	0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64,
+	0x22, 0x41, 0x0a, 0x10, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x54, 0x61, 0x67, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x49, 0x64, 0x12,
+	0x12, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x74,
+	0x61, 0x67, 0x73, 0x22, 0x68, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6d, 0x61, 0x67, 0x65,
+	0x73, 0x42, 0x79, 0x54, 0x61, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a,
+	0x03, 0x74, 0x61, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x74, 0x61, 0x67, 0x12,
+	0x1f, 0x0a, 0x0b, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72,
+	0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x22, 0xee, 0x01,
+	0x0a, 0x13, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x65,
+	0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x65,
+	0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x75, 0x70, 0x6c, 0x6f, 0x61,
+	0x64, 0x65, 0x64, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0d, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x64, 0x41, 0x66, 0x74, 0x65, 0x72, 0x12, 0x27,
+	0x0a, 0x0f, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x64, 0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x65,
+	0x64, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x77, 0x6e, 0x65, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x77, 0x6e, 0x65, 0x72,
+	0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12,
+	0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x6c,
+	0x0a, 0x0c, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x2a,
+	0x0a, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e,
+	0x70, 0x68, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x64, 0x49, 0x6d,
+	0x61, 0x67, 0x65, 0x52, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x61,
+	0x6e, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x02, 0x52, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x12, 0x1c,
+	0x0a, 0x09, 0x68, 0x69, 0x67, 0x68, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x68, 0x69, 0x67, 0x68, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x22, 0x6d, 0x0a, 0x14,
+	0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x65,
+	0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65,
+	0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65,
+	0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0xb4, 0x01, 0x0a, 0x17,
+	0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x4e, 0x65, 0x61, 0x72,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x74, 0x69, 0x74,
+	0x75, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x6c, 0x61, 0x74, 0x69, 0x74,
+	0x75, 0x64, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64,
+	0x65, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x61, 0x64, 0x69, 0x75, 0x73, 0x5f, 0x6d, 0x65, 0x74, 0x65,
+	0x72, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x72, 0x61, 0x64, 0x69, 0x75, 0x73,
+	0x4d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73,
+	0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53,
+	0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x22, 0xe6, 0x01, 0x0a, 0x18, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x49, 0x6d, 0x61,
+	0x67, 0x65, 0x73, 0x49, 0x6e, 0x42, 0x6f, 0x78, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x21, 0x0a, 0x0c, 0x6d, 0x69, 0x6e, 0x5f, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x6d, 0x69, 0x6e, 0x4c, 0x61, 0x74, 0x69, 0x74, 0x75,
+	0x64, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x6d, 0x69, 0x6e, 0x5f, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74,
+	0x75, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x6d, 0x69, 0x6e, 0x4c, 0x6f,
+	0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x61, 0x78, 0x5f, 0x6c,
+	0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x6d,
+	0x61, 0x78, 0x4c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x6d, 0x61,
+	0x78, 0x5f, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x0c, 0x6d, 0x61, 0x78, 0x4c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x12,
+	0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a,
+	0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x66, 0x0a, 0x0f, 0x47,
+	0x65, 0x6f, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x2a,
+	0x0a, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e,
+	0x70, 0x68, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x64, 0x49, 0x6d,
+	0x61, 0x67, 0x65, 0x52, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x64, 0x69,
+	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x0e, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x4d, 0x65, 0x74,
+	0x65, 0x72, 0x73, 0x22, 0x6d, 0x0a, 0x11, 0x47, 0x65, 0x6f, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x68, 0x6f, 0x74,
+	0x6f, 0x2e, 0x47, 0x65, 0x6f, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65,
+	0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x2a, 0x64, 0x0a, 0x11, 0x54, 0x65, 0x78, 0x74, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x17, 0x0a, 0x13, 0x54, 0x45, 0x58, 0x54, 0x5f,
+	0x44, 0x45, 0x54, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00,
+	0x12, 0x19, 0x0a, 0x15, 0x54, 0x45, 0x58, 0x54, 0x5f, 0x44, 0x45, 0x54, 0x45, 0x43, 0x54, 0x49,
+	0x4f, 0x4e, 0x5f, 0x53, 0x50, 0x41, 0x52, 0x53, 0x45, 0x10, 0x01, 0x12, 0x1b, 0x0a, 0x17, 0x54,
+	0x45, 0x58, 0x54, 0x5f, 0x44, 0x45, 0x54, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x44, 0x4f,
+	0x43, 0x55, 0x4d, 0x45, 0x4e, 0x54, 0x10, 0x02, 0x2a, 0xa7, 0x01, 0x0a, 0x0a, 0x4c, 0x69, 0x6b,
+	0x65, 0x6c, 0x69, 0x68, 0x6f, 0x6f, 0x64, 0x12, 0x16, 0x0a, 0x12, 0x4c, 0x49, 0x4b, 0x45, 0x4c,
+	0x49, 0x48, 0x4f, 0x4f, 0x44, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12,
+	0x1c, 0x0a, 0x18, 0x4c, 0x49, 0x4b, 0x45, 0x4c, 0x49, 0x48, 0x4f, 0x4f, 0x44, 0x5f, 0x56, 0x45,
+	0x52, 0x59, 0x5f, 0x55, 0x4e, 0x4c, 0x49, 0x4b, 0x45, 0x4c, 0x59, 0x10, 0x01, 0x12, 0x17, 0x0a,
+	0x13, 0x4c, 0x49, 0x4b, 0x45, 0x4c, 0x49, 0x48, 0x4f, 0x4f, 0x44, 0x5f, 0x55, 0x4e, 0x4c, 0x49,
+	0x4b, 0x45, 0x4c, 0x59, 0x10, 0x02, 0x12, 0x17, 0x0a, 0x13, 0x4c, 0x49, 0x4b, 0x45, 0x4c, 0x49,
+	0x48, 0x4f, 0x4f, 0x44, 0x5f, 0x50, 0x4f, 0x53, 0x53, 0x49, 0x42, 0x4c, 0x45, 0x10, 0x03, 0x12,
+	0x15, 0x0a, 0x11, 0x4c, 0x49, 0x4b, 0x45, 0x4c, 0x49, 0x48, 0x4f, 0x4f, 0x44, 0x5f, 0x4c, 0x49,
+	0x4b, 0x45, 0x4c, 0x59, 0x10, 0x04, 0x12, 0x1a, 0x0a, 0x16, 0x4c, 0x49, 0x4b, 0x45, 0x4c, 0x49,
+	0x48, 0x4f, 0x4f, 0x44, 0x5f, 0x56, 0x45, 0x52, 0x59, 0x5f, 0x4c, 0x49, 0x4b, 0x45, 0x4c, 0x59,
+	0x10, 0x05, 0x2a, 0x9b, 0x01, 0x0a, 0x10, 0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x21, 0x0a, 0x1d, 0x4d, 0x4f, 0x44, 0x45, 0x52,
+	0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53,
+	0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x17, 0x0a, 0x13, 0x4d, 0x4f,
+	0x44, 0x45, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x41, 0x43, 0x43, 0x45, 0x50, 0x54, 0x45,
+	0x44, 0x10, 0x01, 0x12, 0x1a, 0x0a, 0x16, 0x4d, 0x4f, 0x44, 0x45, 0x52, 0x41, 0x54, 0x49, 0x4f,
+	0x4e, 0x5f, 0x51, 0x55, 0x41, 0x52, 0x41, 0x4e, 0x54, 0x49, 0x4e, 0x45, 0x44, 0x10, 0x02, 0x12,
+	0x17, 0x0a, 0x13, 0x4d, 0x4f, 0x44, 0x45, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x45,
+	0x4a, 0x45, 0x43, 0x54, 0x45, 0x44, 0x10, 0x03, 0x12, 0x16, 0x0a, 0x12, 0x4d, 0x4f, 0x44, 0x45,
+	0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x04,
+	0x2a, 0x52, 0x0a, 0x0e, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69, 0x73, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x15, 0x0a, 0x11, 0x41, 0x4e, 0x41, 0x4c, 0x59, 0x53, 0x49, 0x53, 0x5f, 0x43,
+	0x4f, 0x4d, 0x50, 0x4c, 0x45, 0x54, 0x45, 0x10, 0x00, 0x12, 0x14, 0x0a, 0x10, 0x41, 0x4e, 0x41,
+	0x4c, 0x59, 0x53, 0x49, 0x53, 0x5f, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12,
+	0x13, 0x0a, 0x0f, 0x41, 0x4e, 0x41, 0x4c, 0x59, 0x53, 0x49, 0x53, 0x5f, 0x46, 0x41, 0x49, 0x4c,
+	0x45, 0x44, 0x10, 0x02, 0x2a, 0x6b, 0x0a, 0x0e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x1f, 0x0a, 0x1b, 0x4c, 0x4f, 0x43, 0x41, 0x54, 0x49,
+	0x4f, 0x4e, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43,
+	0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1a, 0x0a, 0x16, 0x4c, 0x4f, 0x43, 0x41, 0x54,
+	0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x43, 0x4c, 0x49, 0x45, 0x4e,
+	0x54, 0x10, 0x01, 0x12, 0x1c, 0x0a, 0x18, 0x4c, 0x4f, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
+	0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x4c, 0x41, 0x4e, 0x44, 0x4d, 0x41, 0x52, 0x4b, 0x10,
+	0x02, 0x2a, 0x8e, 0x01, 0x0a, 0x0b, 0x46, 0x65, 0x65, 0x64, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e,
+	0x67, 0x12, 0x1c, 0x0a, 0x18, 0x46, 0x45, 0x45, 0x44, 0x5f, 0x52, 0x41, 0x4e, 0x4b, 0x49, 0x4e,
+	0x47, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12,
+	0x18, 0x0a, 0x14, 0x46, 0x45, 0x45, 0x44, 0x5f, 0x52, 0x41, 0x4e, 0x4b, 0x49, 0x4e, 0x47, 0x5f,
+	0x52, 0x45, 0x43, 0x45, 0x4e, 0x43, 0x59, 0x10, 0x01, 0x12, 0x18, 0x0a, 0x14, 0x46, 0x45, 0x45,
+	0x44, 0x5f, 0x52, 0x41, 0x4e, 0x4b, 0x49, 0x4e, 0x47, 0x5f, 0x45, 0x4d, 0x4f, 0x54, 0x49, 0x4f,
+	0x4e, 0x10, 0x02, 0x12, 0x14, 0x0a, 0x10, 0x46, 0x45, 0x45, 0x44, 0x5f, 0x52, 0x41, 0x4e, 0x4b,
+	0x49, 0x4e, 0x47, 0x5f, 0x48, 0x4f, 0x54, 0x10, 0x03, 0x12, 0x17, 0x0a, 0x13, 0x46, 0x45, 0x45,
+	0x44, 0x5f, 0x52, 0x41, 0x4e, 0x4b, 0x49, 0x4e, 0x47, 0x5f, 0x52, 0x41, 0x4e, 0x44, 0x4f, 0x4d,
+	0x10, 0x04, 0x2a, 0x3c, 0x0a, 0x0d, 0x46, 0x65, 0x65, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x46, 0x69,
+	0x65, 0x6c, 0x64, 0x12, 0x14, 0x0a, 0x10, 0x46, 0x45, 0x45, 0x44, 0x5f, 0x54, 0x49, 0x4d, 0x45,
+	0x5f, 0x55, 0x50, 0x4c, 0x4f, 0x41, 0x44, 0x10, 0x00, 0x12, 0x15, 0x0a, 0x11, 0x46, 0x45, 0x45,
+	0x44, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x5f, 0x43, 0x41, 0x50, 0x54, 0x55, 0x52, 0x45, 0x10, 0x01,
+	0x2a, 0x64, 0x0a, 0x0c, 0x46, 0x61, 0x63, 0x65, 0x50, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x63, 0x65,
+	0x12, 0x15, 0x0a, 0x11, 0x46, 0x41, 0x43, 0x45, 0x5f, 0x50, 0x52, 0x45, 0x53, 0x45, 0x4e, 0x43,
+	0x45, 0x5f, 0x41, 0x4e, 0x59, 0x10, 0x00, 0x12, 0x1c, 0x0a, 0x18, 0x46, 0x41, 0x43, 0x45, 0x5f,
+	0x50, 0x52, 0x45, 0x53, 0x45, 0x4e, 0x43, 0x45, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x46, 0x41,
+	0x43, 0x45, 0x53, 0x10, 0x01, 0x12, 0x1f, 0x0a, 0x1b, 0x46, 0x41, 0x43, 0x45, 0x5f, 0x50, 0x52,
+	0x45, 0x53, 0x45, 0x4e, 0x43, 0x45, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x4f, 0x55, 0x54, 0x5f, 0x46,
+	0x41, 0x43, 0x45, 0x53, 0x10, 0x02, 0x2a, 0x73, 0x0a, 0x0d, 0x46, 0x65, 0x65, 0x64, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x16, 0x46, 0x45, 0x45, 0x44, 0x5f,
+	0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45,
+	0x44, 0x10, 0x00, 0x12, 0x16, 0x0a, 0x12, 0x46, 0x45, 0x45, 0x44, 0x5f, 0x45, 0x56, 0x45, 0x4e,
+	0x54, 0x5f, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x44, 0x10, 0x01, 0x12, 0x16, 0x0a, 0x12, 0x46,
+	0x45, 0x45, 0x44, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45,
+	0x44, 0x10, 0x02, 0x12, 0x16, 0x0a, 0x12, 0x46, 0x45, 0x45, 0x44, 0x5f, 0x45, 0x56, 0x45, 0x4e,
+	0x54, 0x5f, 0x52, 0x45, 0x4d, 0x4f, 0x56, 0x45, 0x44, 0x10, 0x03, 0x32, 0xda, 0x06, 0x0a, 0x0c,
+	0x50, 0x68, 0x6f, 0x74, 0x6f, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x39, 0x0a, 0x0b,
+	0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x14, 0x2e, 0x70, 0x68,
+	0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x64, 0x49, 0x6d, 0x61, 0x67,
+	0x65, 0x1a, 0x14, 0x2e, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64,
+	0x65, 0x64, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x3c, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x49, 0x6d,
+	0x61, 0x67, 0x65, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x12, 0x14, 0x2e, 0x70, 0x68, 0x6f, 0x74,
+	0x6f, 0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x64, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x1a,
+	0x14, 0x2e, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x64,
+	0x49, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x47, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x49, 0x6d, 0x61, 0x67,
+	0x65, 0x46, 0x65, 0x65, 0x64, 0x12, 0x1a, 0x2e, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65,
+	0x74, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x46, 0x65, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1b, 0x2e, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x6d, 0x61,
+	0x67, 0x65, 0x46, 0x65, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3f,
+	0x0a, 0x11, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x44, 0x65, 0x74,
+	0x61, 0x69, 0x6c, 0x12, 0x14, 0x2e, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x70, 0x6c, 0x6f,
+	0x61, 0x64, 0x65, 0x64, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x1a, 0x14, 0x2e, 0x70, 0x68, 0x6f, 0x74,
+	0x6f, 0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x64, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x12,
+	0x3d, 0x0a, 0x0c, 0x41, 0x64, 0x64, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x54, 0x61, 0x67, 0x73, 0x12,
+	0x17, 0x2e, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x54, 0x61, 0x67,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x70, 0x68, 0x6f, 0x74, 0x6f,
+	0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x64, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x40,
+	0x0a, 0x0f, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x54, 0x61, 0x67,
+	0x73, 0x12, 0x17, 0x2e, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x54,
+	0x61, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x70, 0x68, 0x6f,
+	0x74, 0x6f, 0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x64, 0x49, 0x6d, 0x61, 0x67, 0x65,
+	0x12, 0x4d, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x42, 0x79,
+	0x54, 0x61, 0x67, 0x12, 0x1d, 0x2e, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x49, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x42, 0x79, 0x54, 0x61, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x6d,
+	0x61, 0x67, 0x65, 0x46, 0x65, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x47, 0x0a, 0x0c, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x12,
+	0x1a, 0x2e, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x49, 0x6d,
+	0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x70, 0x68,
+	0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4c, 0x0a, 0x10, 0x53, 0x65, 0x61, 0x72,
+	0x63, 0x68, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x4e, 0x65, 0x61, 0x72, 0x12, 0x1e, 0x2e, 0x70,
+	0x68, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x49, 0x6d, 0x61, 0x67, 0x65,
+	0x73, 0x4e, 0x65, 0x61, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x70,
+	0x68, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x6f, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4e, 0x0a, 0x11, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68,
+	0x49, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x49, 0x6e, 0x42, 0x6f, 0x78, 0x12, 0x1f, 0x2e, 0x70, 0x68,
+	0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x73,
+	0x49, 0x6e, 0x42, 0x6f, 0x78, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x70,
+	0x68, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x6f, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x09, 0x57, 0x61, 0x74, 0x63, 0x68, 0x46,
+	0x65, 0x65, 0x64, 0x12, 0x17, 0x2e, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x2e, 0x57, 0x61, 0x74, 0x63,
+	0x68, 0x46, 0x65, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x70,
+	0x68, 0x6f, 0x74, 0x6f, 0x2e, 0x46, 0x65, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x30, 0x01,
+	0x12, 0x56, 0x0a, 0x11, 0x42, 0x61, 0x74, 0x63, 0x68, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x49,
+	0x6d, 0x61, 0x67, 0x65, 0x73, 0x12, 0x1f, 0x2e, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x2e, 0x42, 0x61,
+	0x74, 0x63, 0x68, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x2e, 0x42,
+	0x61, 0x74, 0x63, 0x68, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x1b, 0x5a, 0x19, 0x6d, 0x79, 0x70, 0x68,
+	0x6f, 0x74, 0x6f, 0x61, 0x70, 0x70, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f,
+	0x70, 0x68, 0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_proto_photo_upload_proto_rawDescOnce sync.Once
+	file_proto_photo_upload_proto_rawDescData = file_proto_photo_upload_proto_rawDesc
+)
+
+func file_proto_photo_upload_proto_rawDescGZIP() []byte {
+	file_proto_photo_upload_proto_rawDescOnce.Do(func() {
+		file_proto_photo_upload_proto_rawDescData = protoimpl.X.CompressGZIP(file_proto_photo_upload_proto_rawDescData)
+	})
+	return file_proto_photo_upload_proto_rawDescData
+}
+
+var file_proto_photo_upload_proto_enumTypes = make([]protoimpl.EnumInfo, 9)
+var file_proto_photo_upload_proto_msgTypes = make([]protoimpl.MessageInfo, 31)
+var file_proto_photo_upload_proto_goTypes = []interface{}{
+	(TextDetectionMode)(0),            // 0: photo.TextDetectionMode
+	(Likelihood)(0),                   // 1: photo.Likelihood
+	(ModerationStatus)(0),             // 2: photo.ModerationStatus
+	(AnalysisStatus)(0),               // 3: photo.AnalysisStatus
+	(LocationSource)(0),               // 4: photo.LocationSource
+	(FeedRanking)(0),                  // 5: photo.FeedRanking
+	(FeedTimeField)(0),                // 6: photo.FeedTimeField
+	(FacePresence)(0),                 // 7: photo.FacePresence
+	(FeedEventType)(0),                // 8: photo.FeedEventType
+	(*FaceAnalysis)(nil),              // 9: photo.FaceAnalysis
+	(*NormalizedVertex)(nil),          // 10: photo.NormalizedVertex
+	(*FaceLandmark)(nil),              // 11: photo.FaceLandmark
+	(*FaceGeometry)(nil),              // 12: photo.FaceGeometry
+	(*LikelihoodScore)(nil),           // 13: photo.LikelihoodScore
+	(*EmotionScores)(nil),             // 14: photo.EmotionScores
+	(*FaceAttributes)(nil),            // 15: photo.FaceAttributes
+	(*Label)(nil),                     // 16: photo.Label
+	(*SafeSearch)(nil),                // 17: photo.SafeSearch
+	(*Location)(nil),                  // 18: photo.Location
+	(*Vertex)(nil),                    // 19: photo.Vertex
+	(*TextBlock)(nil),                 // 20: photo.TextBlock
+	(*DetectedText)(nil),              // 21: photo.DetectedText
+	(*UploadedImage)(nil),             // 22: photo.UploadedImage
+	(*GetImageFeedRequest)(nil),       // 23: photo.GetImageFeedRequest
+	(*FeedFilter)(nil),                // 24: photo.FeedFilter
+	(*GetImageFeedResponse)(nil),      // 25: photo.GetImageFeedResponse
+	(*WatchFeedRequest)(nil),          // 26: photo.WatchFeedRequest
+	(*FeedEvent)(nil),                 // 27: photo.FeedEvent
+	(*BatchUploadImagesRequest)(nil),  // 28: photo.BatchUploadImagesRequest
+	(*BatchUploadResult)(nil),         // 29: photo.BatchUploadResult
+	(*BatchUploadImagesResponse)(nil), // 30: photo.BatchUploadImagesResponse
+	(*ImageTagsRequest)(nil),          // 31: photo.ImageTagsRequest
+	(*ListImagesByTagRequest)(nil),    // 32: photo.ListImagesByTagRequest
+	(*SearchImagesRequest)(nil),       // 33: photo.SearchImagesRequest
+	(*SearchResult)(nil),              // 34: photo.SearchResult
+	(*SearchImagesResponse)(nil),      // 35: photo.SearchImagesResponse
+	(*SearchImagesNearRequest)(nil),   // 36: photo.SearchImagesNearRequest
+	(*SearchImagesInBoxRequest)(nil),  // 37: photo.SearchImagesInBoxRequest
+	(*GeoSearchResult)(nil),           // 38: photo.GeoSearchResult
+	(*GeoSearchResponse)(nil),         // 39: photo.GeoSearchResponse
+}
+var file_proto_photo_upload_proto_depIdxs = []int32{
+	14, // 0: photo.FaceAnalysis.emotions:type_name -> photo.EmotionScores
+	15, // 1: photo.FaceAnalysis.attributes:type_name -> photo.FaceAttributes
+	12, // 2: photo.FaceAnalysis.geometry:type_name -> photo.FaceGeometry
+	10, // 3: photo.FaceGeometry.bounding_poly:type_name -> photo.NormalizedVertex
+	10, // 4: photo.FaceGeometry.fd_bounding_poly:type_name -> photo.NormalizedVertex
+	11, // 5: photo.FaceGeometry.landmarks:type_name -> photo.FaceLandmark
+	1,  // 6: photo.LikelihoodScore.likelihood:type_name -> photo.Likelihood
+	13, // 7: photo.EmotionScores.joy:type_name -> photo.LikelihoodScore
+	13, // 8: photo.EmotionScores.sorrow:type_name -> photo.LikelihoodScore
+	13, // 9: photo.EmotionScores.anger:type_name -> photo.LikelihoodScore
+	13, // 10: photo.EmotionScores.surprise:type_name -> photo.LikelihoodScore
+	13, // 11: photo.FaceAttributes.blurred:type_name -> photo.LikelihoodScore
+	13, // 12: photo.FaceAttributes.headwear:type_name -> photo.LikelihoodScore
+	13, // 13: photo.FaceAttributes.under_exposed:type_name -> photo.LikelihoodScore
+	1,  // 14: photo.SafeSearch.adult:type_name -> photo.Likelihood
+	1,  // 15: photo.SafeSearch.violence:type_name -> photo.Likelihood
+	1,  // 16: photo.SafeSearch.racy:type_name -> photo.Likelihood
+	1,  // 17: photo.SafeSearch.medical:type_name -> photo.Likelihood
+	1,  // 18: photo.SafeSearch.spoof:type_name -> photo.Likelihood
+	4,  // 19: photo.Location.source:type_name -> photo.LocationSource
+	19, // 20: photo.TextBlock.bounding_box:type_name -> photo.Vertex
+	20, // 21: photo.DetectedText.blocks:type_name -> photo.TextBlock
+	9,  // 22: photo.UploadedImage.face_analysis:type_name -> photo.FaceAnalysis
+	16, // 23: photo.UploadedImage.labels:type_name -> photo.Label
+	21, // 24: photo.UploadedImage.text:type_name -> photo.DetectedText
+	0,  // 25: photo.UploadedImage.detect_text:type_name -> photo.TextDetectionMode
+	2,  // 26: photo.UploadedImage.moderation_status:type_name -> photo.ModerationStatus
+	17, // 27: photo.UploadedImage.safe_search:type_name -> photo.SafeSearch
+	18, // 28: photo.UploadedImage.location:type_name -> photo.Location
+	3,  // 29: photo.UploadedImage.analysis_status:type_name -> photo.AnalysisStatus
+	5,  // 30: photo.GetImageFeedRequest.ranking:type_name -> photo.FeedRanking
+	24, // 31: photo.GetImageFeedRequest.filter:type_name -> photo.FeedFilter
+	6,  // 32: photo.FeedFilter.time_field:type_name -> photo.FeedTimeField
+	7,  // 33: photo.FeedFilter.face_presence:type_name -> photo.FacePresence
+	22, // 34: photo.GetImageFeedResponse.images:type_name -> photo.UploadedImage
+	24, // 35: photo.WatchFeedRequest.filter:type_name -> photo.FeedFilter
+	8,  // 36: photo.FeedEvent.type:type_name -> photo.FeedEventType
+	22, // 37: photo.FeedEvent.image:type_name -> photo.UploadedImage
+	22, // 38: photo.BatchUploadImagesRequest.images:type_name -> photo.UploadedImage
+	22, // 39: photo.BatchUploadResult.image:type_name -> photo.UploadedImage
+	29, // 40: photo.BatchUploadImagesResponse.results:type_name -> photo.BatchUploadResult
+	22, // 41: photo.SearchResult.image:type_name -> photo.UploadedImage
+	34, // 42: photo.SearchImagesResponse.results:type_name -> photo.SearchResult
+	22, // 43: photo.GeoSearchResult.image:type_name -> photo.UploadedImage
+	38, // 44: photo.GeoSearchResponse.results:type_name -> photo.GeoSearchResult
+	22, // 45: photo.PhotoService.UploadImage:input_type -> photo.UploadedImage
+	22, // 46: photo.PhotoService.GetImageDetail:input_type -> photo.UploadedImage
+	23, // 47: photo.PhotoService.GetImageFeed:input_type -> photo.GetImageFeedRequest
+	22, // 48: photo.PhotoService.UpdateImageDetail:input_type -> photo.UploadedImage
+	31, // 49: photo.PhotoService.AddImageTags:input_type -> photo.ImageTagsRequest
+	31, // 50: photo.PhotoService.RemoveImageTags:input_type -> photo.ImageTagsRequest
+	32, // 51: photo.PhotoService.ListImagesByTag:input_type -> photo.ListImagesByTagRequest
+	33, // 52: photo.PhotoService.SearchImages:input_type -> photo.SearchImagesRequest
+	36, // 53: photo.PhotoService.SearchImagesNear:input_type -> photo.SearchImagesNearRequest
+	37, // 54: photo.PhotoService.SearchImagesInBox:input_type -> photo.SearchImagesInBoxRequest
+	26, // 55: photo.PhotoService.WatchFeed:input_type -> photo.WatchFeedRequest
+	28, // 56: photo.PhotoService.BatchUploadImages:input_type -> photo.BatchUploadImagesRequest
+	22, // 57: photo.PhotoService.UploadImage:output_type -> photo.UploadedImage
+	22, // 58: photo.PhotoService.GetImageDetail:output_type -> photo.UploadedImage
+	25, // 59: photo.PhotoService.GetImageFeed:output_type -> photo.GetImageFeedResponse
+	22, // 60: photo.PhotoService.UpdateImageDetail:output_type -> photo.UploadedImage
+	22, // 61: photo.PhotoService.AddImageTags:output_type -> photo.UploadedImage
+	22, // 62: photo.PhotoService.RemoveImageTags:output_type -> photo.UploadedImage
+	25, // 63: photo.PhotoService.ListImagesByTag:output_type -> photo.GetImageFeedResponse
+	35, // 64: photo.PhotoService.SearchImages:output_type -> photo.SearchImagesResponse
+	39, // 65: photo.PhotoService.SearchImagesNear:output_type -> photo.GeoSearchResponse
+	39, // 66: photo.PhotoService.SearchImagesInBox:output_type -> photo.GeoSearchResponse
+	27, // 67: photo.PhotoService.WatchFeed:output_type -> photo.FeedEvent
+	30, // 68: photo.PhotoService.BatchUploadImages:output_type -> photo.BatchUploadImagesResponse
+	57, // [57:69] is the sub-list for method output_type
+	45, // [45:57] is the sub-list for method input_type
+	45, // [45:45] is the sub-list for extension type_name
+	45, // [45:45] is the sub-list for extension extendee
+	0,  // [0:45] is the sub-list for field type_name
+}
+
+func init() { file_proto_photo_upload_proto_init() }
+func file_proto_photo_upload_proto_init() {
+	if File_proto_photo_upload_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_proto_photo_upload_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FaceAnalysis); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_photo_upload_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NormalizedVertex); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_photo_upload_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FaceLandmark); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_photo_upload_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FaceGeometry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_photo_upload_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LikelihoodScore); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_photo_upload_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EmotionScores); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_photo_upload_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FaceAttributes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_photo_upload_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Label); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_photo_upload_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SafeSearch); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_photo_upload_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Location); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_photo_upload_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Vertex); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_photo_upload_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TextBlock); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_photo_upload_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DetectedText); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_photo_upload_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UploadedImage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_photo_upload_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetImageFeedRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_photo_upload_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FeedFilter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_photo_upload_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetImageFeedResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_photo_upload_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchFeedRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_photo_upload_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FeedEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_photo_upload_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchUploadImagesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_photo_upload_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchUploadResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_photo_upload_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchUploadImagesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_photo_upload_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImageTagsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_photo_upload_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListImagesByTagRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_photo_upload_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchImagesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_photo_upload_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_photo_upload_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchImagesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_photo_upload_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchImagesNearRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_photo_upload_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchImagesInBoxRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_photo_upload_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GeoSearchResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_photo_upload_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GeoSearchResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -395,13 +3774,14 @@ func file_proto_photo_upload_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_proto_photo_upload_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   4,
+			NumEnums:      9,
+			NumMessages:   31,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_proto_photo_upload_proto_goTypes,
 		DependencyIndexes: file_proto_photo_upload_proto_depIdxs,
+		EnumInfos:         file_proto_photo_upload_proto_enumTypes,
 		MessageInfos:      file_proto_photo_upload_proto_msgTypes,
 	}.Build()
 	File_proto_photo_upload_proto = out.File
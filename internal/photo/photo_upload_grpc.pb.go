@@ -23,6 +23,14 @@ const (
 	PhotoService_GetImageDetail_FullMethodName    = "/photo.PhotoService/GetImageDetail"
 	PhotoService_GetImageFeed_FullMethodName      = "/photo.PhotoService/GetImageFeed"
 	PhotoService_UpdateImageDetail_FullMethodName = "/photo.PhotoService/UpdateImageDetail"
+	PhotoService_AddImageTags_FullMethodName      = "/photo.PhotoService/AddImageTags"
+	PhotoService_RemoveImageTags_FullMethodName   = "/photo.PhotoService/RemoveImageTags"
+	PhotoService_ListImagesByTag_FullMethodName   = "/photo.PhotoService/ListImagesByTag"
+	PhotoService_SearchImages_FullMethodName      = "/photo.PhotoService/SearchImages"
+	PhotoService_SearchImagesNear_FullMethodName  = "/photo.PhotoService/SearchImagesNear"
+	PhotoService_SearchImagesInBox_FullMethodName = "/photo.PhotoService/SearchImagesInBox"
+	PhotoService_WatchFeed_FullMethodName         = "/photo.PhotoService/WatchFeed"
+	PhotoService_BatchUploadImages_FullMethodName = "/photo.PhotoService/BatchUploadImages"
 )
 
 // PhotoServiceClient is the client API for PhotoService service.
@@ -33,6 +41,14 @@ type PhotoServiceClient interface {
 	GetImageDetail(ctx context.Context, in *UploadedImage, opts ...grpc.CallOption) (*UploadedImage, error)
 	GetImageFeed(ctx context.Context, in *GetImageFeedRequest, opts ...grpc.CallOption) (*GetImageFeedResponse, error)
 	UpdateImageDetail(ctx context.Context, in *UploadedImage, opts ...grpc.CallOption) (*UploadedImage, error)
+	AddImageTags(ctx context.Context, in *ImageTagsRequest, opts ...grpc.CallOption) (*UploadedImage, error)
+	RemoveImageTags(ctx context.Context, in *ImageTagsRequest, opts ...grpc.CallOption) (*UploadedImage, error)
+	ListImagesByTag(ctx context.Context, in *ListImagesByTagRequest, opts ...grpc.CallOption) (*GetImageFeedResponse, error)
+	SearchImages(ctx context.Context, in *SearchImagesRequest, opts ...grpc.CallOption) (*SearchImagesResponse, error)
+	SearchImagesNear(ctx context.Context, in *SearchImagesNearRequest, opts ...grpc.CallOption) (*GeoSearchResponse, error)
+	SearchImagesInBox(ctx context.Context, in *SearchImagesInBoxRequest, opts ...grpc.CallOption) (*GeoSearchResponse, error)
+	WatchFeed(ctx context.Context, in *WatchFeedRequest, opts ...grpc.CallOption) (PhotoService_WatchFeedClient, error)
+	BatchUploadImages(ctx context.Context, in *BatchUploadImagesRequest, opts ...grpc.CallOption) (*BatchUploadImagesResponse, error)
 }
 
 type photoServiceClient struct {
@@ -79,6 +95,101 @@ func (c *photoServiceClient) UpdateImageDetail(ctx context.Context, in *Uploaded
 	return out, nil
 }
 
+func (c *photoServiceClient) AddImageTags(ctx context.Context, in *ImageTagsRequest, opts ...grpc.CallOption) (*UploadedImage, error) {
+	out := new(UploadedImage)
+	err := c.cc.Invoke(ctx, PhotoService_AddImageTags_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *photoServiceClient) RemoveImageTags(ctx context.Context, in *ImageTagsRequest, opts ...grpc.CallOption) (*UploadedImage, error) {
+	out := new(UploadedImage)
+	err := c.cc.Invoke(ctx, PhotoService_RemoveImageTags_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *photoServiceClient) ListImagesByTag(ctx context.Context, in *ListImagesByTagRequest, opts ...grpc.CallOption) (*GetImageFeedResponse, error) {
+	out := new(GetImageFeedResponse)
+	err := c.cc.Invoke(ctx, PhotoService_ListImagesByTag_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *photoServiceClient) SearchImages(ctx context.Context, in *SearchImagesRequest, opts ...grpc.CallOption) (*SearchImagesResponse, error) {
+	out := new(SearchImagesResponse)
+	err := c.cc.Invoke(ctx, PhotoService_SearchImages_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *photoServiceClient) SearchImagesNear(ctx context.Context, in *SearchImagesNearRequest, opts ...grpc.CallOption) (*GeoSearchResponse, error) {
+	out := new(GeoSearchResponse)
+	err := c.cc.Invoke(ctx, PhotoService_SearchImagesNear_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *photoServiceClient) SearchImagesInBox(ctx context.Context, in *SearchImagesInBoxRequest, opts ...grpc.CallOption) (*GeoSearchResponse, error) {
+	out := new(GeoSearchResponse)
+	err := c.cc.Invoke(ctx, PhotoService_SearchImagesInBox_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *photoServiceClient) WatchFeed(ctx context.Context, in *WatchFeedRequest, opts ...grpc.CallOption) (PhotoService_WatchFeedClient, error) {
+	stream, err := c.cc.NewStream(ctx, &PhotoService_ServiceDesc.Streams[0], PhotoService_WatchFeed_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &photoServiceWatchFeedClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type PhotoService_WatchFeedClient interface {
+	Recv() (*FeedEvent, error)
+	grpc.ClientStream
+}
+
+type photoServiceWatchFeedClient struct {
+	grpc.ClientStream
+}
+
+func (x *photoServiceWatchFeedClient) Recv() (*FeedEvent, error) {
+	m := new(FeedEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *photoServiceClient) BatchUploadImages(ctx context.Context, in *BatchUploadImagesRequest, opts ...grpc.CallOption) (*BatchUploadImagesResponse, error) {
+	out := new(BatchUploadImagesResponse)
+	err := c.cc.Invoke(ctx, PhotoService_BatchUploadImages_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // PhotoServiceServer is the server API for PhotoService service.
 // All implementations must embed UnimplementedPhotoServiceServer
 // for forward compatibility
@@ -87,6 +198,14 @@ type PhotoServiceServer interface {
 	GetImageDetail(context.Context, *UploadedImage) (*UploadedImage, error)
 	GetImageFeed(context.Context, *GetImageFeedRequest) (*GetImageFeedResponse, error)
 	UpdateImageDetail(context.Context, *UploadedImage) (*UploadedImage, error)
+	AddImageTags(context.Context, *ImageTagsRequest) (*UploadedImage, error)
+	RemoveImageTags(context.Context, *ImageTagsRequest) (*UploadedImage, error)
+	ListImagesByTag(context.Context, *ListImagesByTagRequest) (*GetImageFeedResponse, error)
+	SearchImages(context.Context, *SearchImagesRequest) (*SearchImagesResponse, error)
+	SearchImagesNear(context.Context, *SearchImagesNearRequest) (*GeoSearchResponse, error)
+	SearchImagesInBox(context.Context, *SearchImagesInBoxRequest) (*GeoSearchResponse, error)
+	WatchFeed(*WatchFeedRequest, PhotoService_WatchFeedServer) error
+	BatchUploadImages(context.Context, *BatchUploadImagesRequest) (*BatchUploadImagesResponse, error)
 	mustEmbedUnimplementedPhotoServiceServer()
 }
 
@@ -106,6 +225,30 @@ func (UnimplementedPhotoServiceServer) GetImageFeed(context.Context, *GetImageFe
 func (UnimplementedPhotoServiceServer) UpdateImageDetail(context.Context, *UploadedImage) (*UploadedImage, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateImageDetail not implemented")
 }
+func (UnimplementedPhotoServiceServer) AddImageTags(context.Context, *ImageTagsRequest) (*UploadedImage, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddImageTags not implemented")
+}
+func (UnimplementedPhotoServiceServer) RemoveImageTags(context.Context, *ImageTagsRequest) (*UploadedImage, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveImageTags not implemented")
+}
+func (UnimplementedPhotoServiceServer) ListImagesByTag(context.Context, *ListImagesByTagRequest) (*GetImageFeedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListImagesByTag not implemented")
+}
+func (UnimplementedPhotoServiceServer) SearchImages(context.Context, *SearchImagesRequest) (*SearchImagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchImages not implemented")
+}
+func (UnimplementedPhotoServiceServer) SearchImagesNear(context.Context, *SearchImagesNearRequest) (*GeoSearchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchImagesNear not implemented")
+}
+func (UnimplementedPhotoServiceServer) SearchImagesInBox(context.Context, *SearchImagesInBoxRequest) (*GeoSearchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchImagesInBox not implemented")
+}
+func (UnimplementedPhotoServiceServer) WatchFeed(*WatchFeedRequest, PhotoService_WatchFeedServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchFeed not implemented")
+}
+func (UnimplementedPhotoServiceServer) BatchUploadImages(context.Context, *BatchUploadImagesRequest) (*BatchUploadImagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchUploadImages not implemented")
+}
 func (UnimplementedPhotoServiceServer) mustEmbedUnimplementedPhotoServiceServer() {}
 
 // UnsafePhotoServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -191,6 +334,153 @@ func _PhotoService_UpdateImageDetail_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PhotoService_AddImageTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImageTagsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PhotoServiceServer).AddImageTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PhotoService_AddImageTags_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PhotoServiceServer).AddImageTags(ctx, req.(*ImageTagsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PhotoService_RemoveImageTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImageTagsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PhotoServiceServer).RemoveImageTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PhotoService_RemoveImageTags_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PhotoServiceServer).RemoveImageTags(ctx, req.(*ImageTagsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PhotoService_ListImagesByTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListImagesByTagRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PhotoServiceServer).ListImagesByTag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PhotoService_ListImagesByTag_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PhotoServiceServer).ListImagesByTag(ctx, req.(*ListImagesByTagRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PhotoService_SearchImages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchImagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PhotoServiceServer).SearchImages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PhotoService_SearchImages_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PhotoServiceServer).SearchImages(ctx, req.(*SearchImagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PhotoService_SearchImagesNear_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchImagesNearRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PhotoServiceServer).SearchImagesNear(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PhotoService_SearchImagesNear_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PhotoServiceServer).SearchImagesNear(ctx, req.(*SearchImagesNearRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PhotoService_SearchImagesInBox_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchImagesInBoxRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PhotoServiceServer).SearchImagesInBox(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PhotoService_SearchImagesInBox_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PhotoServiceServer).SearchImagesInBox(ctx, req.(*SearchImagesInBoxRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PhotoService_WatchFeed_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchFeedRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(PhotoServiceServer).WatchFeed(m, &photoServiceWatchFeedServer{stream})
+}
+
+type PhotoService_WatchFeedServer interface {
+	Send(*FeedEvent) error
+	grpc.ServerStream
+}
+
+type photoServiceWatchFeedServer struct {
+	grpc.ServerStream
+}
+
+func (x *photoServiceWatchFeedServer) Send(m *FeedEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _PhotoService_BatchUploadImages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchUploadImagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PhotoServiceServer).BatchUploadImages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PhotoService_BatchUploadImages_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PhotoServiceServer).BatchUploadImages(ctx, req.(*BatchUploadImagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // PhotoService_ServiceDesc is the grpc.ServiceDesc for PhotoService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -214,7 +504,41 @@ var PhotoService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateImageDetail",
 			Handler:    _PhotoService_UpdateImageDetail_Handler,
 		},
+		{
+			MethodName: "AddImageTags",
+			Handler:    _PhotoService_AddImageTags_Handler,
+		},
+		{
+			MethodName: "RemoveImageTags",
+			Handler:    _PhotoService_RemoveImageTags_Handler,
+		},
+		{
+			MethodName: "ListImagesByTag",
+			Handler:    _PhotoService_ListImagesByTag_Handler,
+		},
+		{
+			MethodName: "SearchImages",
+			Handler:    _PhotoService_SearchImages_Handler,
+		},
+		{
+			MethodName: "SearchImagesNear",
+			Handler:    _PhotoService_SearchImagesNear_Handler,
+		},
+		{
+			MethodName: "SearchImagesInBox",
+			Handler:    _PhotoService_SearchImagesInBox_Handler,
+		},
+		{
+			MethodName: "BatchUploadImages",
+			Handler:    _PhotoService_BatchUploadImages_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchFeed",
+			Handler:       _PhotoService_WatchFeed_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/photo_upload.proto",
 }
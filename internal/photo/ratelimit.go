@@ -0,0 +1,181 @@
+package photo
+
+import (
+	"context"
+	"math"
+	"path"
+	"strconv"
+	"sync"
+	"time"
+
+	"myphotoapp/config"
+
+	"golang.org/x/time/rate"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+const (
+	retryAfterHeader = "retry-after" // Saniye; HTTP Retry-After başlığıyla aynı anlamda
+
+	limiterIdleTimeout = 10 * time.Minute // Bu süre kullanılmayan kovalar silinir
+)
+
+// tenantIdentity, isteğin kiracısını doğrulanmış istemci sertifikasının kuruluşundan, yoksa istemci
+// kimliğinden belirler. İstemcinin istekte bildirdiği kiracıya güvenilmez.
+func tenantIdentity(ctx context.Context) string {
+	if cert := verifiedPeerCertificate(ctx); cert != nil && len(cert.Subject.Organization) > 0 {
+		return cert.Subject.Organization[0]
+	}
+	return clientIdentity(ctx)
+}
+
+// resourceExhausted, ResourceExhausted hatası üretir ve istemcinin ne zaman yeniden deneyebileceğini hem
+// retry-after başlığında hem de RetryInfo ayrıntısında bildirir.
+func resourceExhausted(ctx context.Context, retryAfter time.Duration, format string, args ...any) error {
+	seconds := int64(math.Ceil(retryAfter.Seconds()))
+	if seconds < 1 {
+		seconds = 1
+	}
+	_ = grpc.SetHeader(ctx, metadata.Pairs(retryAfterHeader, strconv.FormatInt(seconds, 10)))
+	return retryableError(retryAfter, format, args...)
+}
+
+// retryableError, yeniden deneme süresini yalnızca RetryInfo ayrıntısında bildiren bir ResourceExhausted hatası
+// üretir. Toplu isteklerdeki öğe sonuçları gibi başlık taşımayan hatalarda kullanılır.
+func retryableError(retryAfter time.Duration, format string, args ...any) error {
+	st := status.Newf(codes.ResourceExhausted, format, args...)
+	if detailed, err := st.WithDetails(&errdetails.RetryInfo{RetryDelay: durationpb.New(retryAfter)}); err == nil {
+		st = detailed
+	}
+	return st.Err()
+}
+
+// rateLimiterKey, RPC bağlamında isteği sınırlayan RateLimiter'ı taşır.
+type rateLimiterKey struct{}
+
+// limitItem, toplu bir istekteki tek bir öğeyi, öğe ayrı bir RPC ile gönderilmiş gibi verilen RPC'nin sınırına
+// tabi tutar. Böylece toplu istek, tek istekli RPC'nin sınırını aşmak için kullanılamaz. İstek sınırlanmıyorsa
+// nil döner.
+func limitItem(ctx context.Context, fullMethod string) error {
+	l, ok := ctx.Value(rateLimiterKey{}).(*RateLimiter)
+	if !ok {
+		return nil
+	}
+	if retryAfter, ok := l.reserve(clientIdentity(ctx), fullMethod); !ok {
+		return retryableError(retryAfter, "istek sınırı aşıldı; %s sonra yeniden deneyin", retryAfter.Round(time.Millisecond))
+	}
+	return nil
+}
+
+type limiterKey struct {
+	client string
+	method string
+}
+
+type limiterEntry struct {
+	limiter  *rate.Limiter
+	lastSeen time.Time
+}
+
+// RateLimiter, her istemci ve RPC çifti için ayrı bir jeton kovası tutan gRPC önleyicisidir.
+type RateLimiter struct {
+	defaults config.RateLimit
+	methods  map[string]config.RateLimit
+
+	mu        sync.Mutex
+	limiters  map[limiterKey]*limiterEntry
+	lastSweep time.Time
+}
+
+// NewRateLimiter, yapılandırmaya göre yeni bir RateLimiter oluşturur. Sınırlama kapalıysa nil döner.
+func NewRateLimiter(cfg config.RateLimitConfig) *RateLimiter {
+	if !cfg.Enabled {
+		return nil
+	}
+	return &RateLimiter{
+		defaults:  cfg.Default,
+		methods:   cfg.Methods,
+		limiters:  make(map[limiterKey]*limiterEntry),
+		lastSweep: now(),
+	}
+}
+
+// limitFor, RPC'nin sınırını tam yoluna, yoksa adına göre bulur; ikisi de yoksa varsayılanı döndürür.
+func (l *RateLimiter) limitFor(fullMethod string) config.RateLimit {
+	if limit, ok := l.methods[fullMethod]; ok {
+		return limit
+	}
+	if limit, ok := l.methods[path.Base(fullMethod)]; ok {
+		return limit
+	}
+	return l.defaults
+}
+
+// reserve, istemcinin RPC için bir jeton almasını dener. Jeton yoksa bir sonraki jetonun ne kadar sonra
+// dolacağını döndürür.
+func (l *RateLimiter) reserve(client, fullMethod string) (time.Duration, bool) {
+	limit := l.limitFor(fullMethod)
+	if limit.RPS <= 0 {
+		return 0, true
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	t := now()
+	if t.Sub(l.lastSweep) > limiterIdleTimeout {
+		for key, entry := range l.limiters {
+			if t.Sub(entry.lastSeen) > limiterIdleTimeout {
+				delete(l.limiters, key)
+			}
+		}
+		l.lastSweep = t
+	}
+
+	key := limiterKey{client: client, method: fullMethod}
+	entry, ok := l.limiters[key]
+	if !ok {
+		burst := limit.Burst
+		if burst <= 0 {
+			burst = int(math.Ceil(limit.RPS))
+		}
+		entry = &limiterEntry{limiter: rate.NewLimiter(rate.Limit(limit.RPS), burst)}
+		l.limiters[key] = entry
+	}
+	entry.lastSeen = t
+
+	r := entry.limiter.ReserveN(t, 1)
+	if !r.OK() {
+		return time.Second, false
+	}
+	if delay := r.DelayFrom(t); delay > 0 {
+		r.CancelAt(t)
+		return delay, false
+	}
+	return 0, true
+}
+
+// UnaryInterceptor, tekli RPC'lere sınır uygular. Toplu isteklerin öğeleri limitItem ile ayrıca sınırlanır.
+func (l *RateLimiter) UnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if retryAfter, ok := l.reserve(clientIdentity(ctx), info.FullMethod); !ok {
+			return nil, resourceExhausted(ctx, retryAfter, "istek sınırı aşıldı; %s sonra yeniden deneyin", retryAfter.Round(time.Millisecond))
+		}
+		return handler(context.WithValue(ctx, rateLimiterKey{}, l), req)
+	}
+}
+
+// StreamInterceptor, akış RPC'lerinin açılmasına sınır uygular.
+func (l *RateLimiter) StreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if retryAfter, ok := l.reserve(clientIdentity(ss.Context()), info.FullMethod); !ok {
+			return resourceExhausted(ss.Context(), retryAfter, "istek sınırı aşıldı; %s sonra yeniden deneyin", retryAfter.Round(time.Millisecond))
+		}
+		return handler(srv, ss)
+	}
+}
@@ -0,0 +1,39 @@
+package photo
+
+import (
+	"context"
+	"testing"
+
+	"myphotoapp/config"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestTenantIdentityIgnoresClaimedTenant(t *testing.T) {
+	ctx := metadata.NewIncomingContext(peerContext("203.0.113.7"), metadata.Pairs("x-tenant-id", "someone-else"))
+	if got := tenantIdentity(ctx); got != "203.0.113.7" {
+		t.Errorf("tenantIdentity = %q, want bağlantı adresi", got)
+	}
+}
+
+func TestLimitItem(t *testing.T) {
+	l := NewRateLimiter(config.RateLimitConfig{
+		Enabled: true,
+		Methods: map[string]config.RateLimit{"UploadImage": {RPS: 0.001, Burst: 2}},
+	})
+	ctx := context.WithValue(peerContext("203.0.113.7"), rateLimiterKey{}, l)
+
+	for i := 0; i < 2; i++ {
+		if err := limitItem(ctx, PhotoService_UploadImage_FullMethodName); err != nil {
+			t.Fatalf("%d. öğe: %v", i+1, err)
+		}
+	}
+	if err := limitItem(ctx, PhotoService_UploadImage_FullMethodName); status.Code(err) != codes.ResourceExhausted {
+		t.Errorf("3. öğe = %v, want ResourceExhausted", err)
+	}
+	if err := limitItem(peerContext("198.51.100.1"), PhotoService_UploadImage_FullMethodName); err != nil {
+		t.Errorf("sınırlanmayan istek = %v, want nil", err)
+	}
+}
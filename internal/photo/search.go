@@ -0,0 +1,171 @@
+package photo
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// searchHeadlineOptions, ts_headline'ın eşleşen kelimeleri nasıl işaretleyeceğini belirler.
+const searchHeadlineOptions = "StartSel=<mark>, StopSel=</mark>, MaxFragments=2, MinWords=3, MaxWords=12"
+
+// searchParams, SearchImages isteğinin doğrulanmış ve normalleştirilmiş hâlidir.
+type searchParams struct {
+	Query          string
+	Emotions       []string // Küçük harfe çevrilmiş duygu adları
+	UploadedAfter  time.Time
+	UploadedBefore time.Time
+	OwnerID        string
+	ViewerID       string // Kişi adları yalnızca bu istemcinin kişileri için aranır
+}
+
+// digest, parametrelerin sayfa belirtecine gömülecek özetini döndürür.
+func (p searchParams) digest() string {
+	return filterDigest("search", p.Query, strings.Join(p.Emotions, ","),
+		strconv.FormatInt(unixOrZero(p.UploadedAfter), 10), strconv.FormatInt(unixOrZero(p.UploadedBefore), 10), p.OwnerID, p.ViewerID)
+}
+
+// unixOrZero, sıfır zamanı 0 olarak, diğerlerini Unix saniye olarak döndürür.
+func unixOrZero(t time.Time) int64 {
+	if t.IsZero() {
+		return 0
+	}
+	return t.Unix()
+}
+
+// SearchImages, fotoğrafları etiketler, açıklama ve kullanıcı etiketleri üzerinde tam metin arama ile bulur.
+// Yüzlere atanan kişi adları yalnızca isteği yapan istemcinin kendi kişileri için eşleşir.
+// Sonuçlar alaka düzeyine, sonra yüklenme tarihine göre sıralanır.
+func (s *PhotoService) SearchImages(ctx context.Context, req *SearchImagesRequest) (*SearchImagesResponse, error) {
+	params := searchParams{
+		Query:    strings.TrimSpace(req.Query),
+		OwnerID:  req.OwnerId,
+		ViewerID: clientIdentity(ctx),
+	}
+	for _, emotion := range req.Emotions {
+		if emotion = strings.ToLower(strings.TrimSpace(emotion)); emotion != "" {
+			params.Emotions = append(params.Emotions, emotion)
+		}
+	}
+	if req.UploadedAfter > 0 {
+		params.UploadedAfter = time.Unix(req.UploadedAfter, 0)
+	}
+	if req.UploadedBefore > 0 {
+		params.UploadedBefore = time.Unix(req.UploadedBefore, 0)
+	}
+	if !params.UploadedAfter.IsZero() && !params.UploadedBefore.IsZero() && !params.UploadedAfter.Before(params.UploadedBefore) {
+		return nil, status.Error(codes.InvalidArgument, "uploaded_after, uploaded_before değerinden önce olmalıdır")
+	}
+
+	pageSize := clampPageSize(req.PageSize)
+	page, err := resolvePage(req.PageToken, 0, pageSize, params.digest())
+	if err != nil {
+		return nil, err
+	}
+
+	results, hasMore, err := SearchPhotos(ctx, params, page, pageSize)
+	if err != nil {
+		return nil, fmt.Errorf("Fotoğraf araması yapılamadı: %v", err)
+	}
+	for _, result := range results {
+		result.Image = personsVisibleTo(params.ViewerID, result.Image)
+	}
+
+	return &SearchImagesResponse{
+		Results:       results,
+		NextPageToken: nextPageToken(page, pageSize, hasMore),
+	}, nil
+}
+
+// SearchPhotos, arama parametrelerine uyan fotoğrafların bir sayfasını alaka puanı ve vurgulanmış metinle döndürür.
+// Sorgu boşsa yalnızca filtreler uygulanır ve sonuçlar yüklenme tarihine göre sıralanır.
+func SearchPhotos(ctx context.Context, params searchParams, page pageToken, pageSize int) ([]*SearchResult, bool, error) {
+	var args []any
+	arg := func(v any) string {
+		args = append(args, v)
+		return "$" + strconv.Itoa(len(args))
+	}
+
+	from := "photos p"
+	rank := "0::real"
+	headline := "''"
+	conds := []string{
+		"p.upload_time <= " + arg(time.Unix(page.Snapshot, 0).UTC()),
+		"p.moderation_status = 'ACCEPTED'",
+	}
+
+	if params.Query != "" {
+		from += ", websearch_to_tsquery('simple', " + arg(params.Query) + ") q"
+		rank = "ts_rank_cd(p.search_vector, q)"
+		headline = "ts_headline('simple', coalesce(p.search_text, ''), q, '" + searchHeadlineOptions + "')"
+		match := "p.search_vector @@ q"
+		// Kişi adları ortak arama dizinine yazılmaz; yalnızca arayanın kendi kişileriyle eşleştirilir.
+		if params.ViewerID != "" {
+			personRank := `(SELECT max(ts_rank_cd(to_tsvector('simple', pr.name), q))
+                FROM photo_faces f JOIN persons pr ON pr.id = f.person_id
+                WHERE f.photo_id = p.id AND pr.owner_id = ` + arg(params.ViewerID) + `
+                  AND to_tsvector('simple', pr.name) @@ q)`
+			rank += " + coalesce(" + personRank + ", 0)"
+			match = "(" + match + " OR " + personRank + " IS NOT NULL)"
+		}
+		conds = append(conds, match)
+	}
+	if len(params.Emotions) > 0 {
+		conds = append(conds, "lower(p.emotion) = ANY("+arg(params.Emotions)+")")
+	}
+	if !params.UploadedAfter.IsZero() {
+		conds = append(conds, "p.upload_time >= "+arg(params.UploadedAfter.UTC()))
+	}
+	if !params.UploadedBefore.IsZero() {
+		conds = append(conds, "p.upload_time < "+arg(params.UploadedBefore.UTC()))
+	}
+	if params.OwnerID != "" {
+		conds = append(conds, "p.owner_id = "+arg(params.OwnerID))
+	}
+
+	query := `SELECT ` + photoColumns + `, ` + rank + ` AS rank, ` + headline + ` AS highlight
+        FROM ` + from + `
+        WHERE ` + strings.Join(conds, " AND ") + `
+        ORDER BY rank DESC, p.upload_time DESC, p.id DESC
+        LIMIT ` + arg(pageSize+1) + ` OFFSET ` + arg(page.Offset)
+
+	rows, err := db.QueryContext(ctx, query, args...)
+	if err != nil {
+		slog.ErrorContext(ctx, "Fotoğraf araması yapılamadı", "error", err)
+		return nil, false, err
+	}
+	defer rows.Close()
+
+	var results []*SearchResult
+	var images []*UploadedImage
+	for rows.Next() {
+		result := &SearchResult{}
+		img, err := scanPhoto(rows, &result.Rank, &result.Highlight)
+		if err != nil {
+			slog.ErrorContext(ctx, "Fotoğraf alınamadı", "error", err)
+			return nil, false, err
+		}
+		result.Image = img
+		results = append(results, result)
+		images = append(images, img)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, false, err
+	}
+
+	hasMore := len(results) > pageSize
+	if hasMore {
+		results = results[:pageSize]
+		images = images[:pageSize]
+	}
+	if err := loadImageDetails(ctx, images); err != nil {
+		return nil, false, err
+	}
+	return results, hasMore, nil
+}
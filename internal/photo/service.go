@@ -3,116 +3,136 @@ package photo
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
+	"errors"
 	"fmt"
-	"log"
-	"sort"
+	"log/slog"
 	"strconv"
 	"time"
 	stdtime "time"
+
+	"myphotoapp/config"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 var now = stdtime.Now
 
-// Image, fotoğraf bilgilerini temsil eder.
-type Image struct {
-	Id           string
-	Url          string
-	FaceAnalysis []*FaceAnalysis
-	UploadTime   int64
-}
-
 // PhotoService, fotoğraf işlemleriyle ilgili istekleri yöneten bir yapıdır.
 type PhotoService struct {
-	kafkaProducer  *KafkaProducer
-	visionAPI      *VisionAPI
-	uploadedImages []*UploadedImage // Yüklenen fotoğrafları saklamak için bir dilim
-	dbImages       []*UploadedImage // Veritabanından çekilen fotoğrafları saklamak için bir dilim
-	lastImageID    int              // Son atanan ID'yi takip etmek için
+	UnimplementedPhotoServiceServer
+
+	kafkaProducer *KafkaProducer
+	visionAPI     *VisionAPI
+	moderation    *ModerationPolicy
+	locations     LocationRepository
+	emotions      *EmotionClassifier
+	rankers       *FeedRankers
+	feed          *FeedBroadcaster
+	batch         config.BatchConfig
 }
 
-// GetHighestPhotoIDFromDB, veritabanındaki en yüksek ID değerini alır.
-func GetHighestPhotoIDFromDB() (int, error) {
-	var highestID int
-	err := db.QueryRow("SELECT COALESCE(MAX(id), 0) FROM photos").Scan(&highestID)
+// NewPhotoService, yeni bir PhotoService örneği oluşturur. Yapılandırmadaki politikalar burada doğrulanır.
+func NewPhotoService(kp *KafkaProducer, va *VisionAPI, locations LocationRepository, emotions *EmotionClassifier,
+	feed *FeedBroadcaster, cfg *config.Config) (*PhotoService, error) {
+	moderation, err := NewModerationPolicy(cfg.Moderation)
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
-	return highestID, nil
-}
 
-// NewPhotoService, yeni bir PhotoService örneği oluşturur.
-func NewPhotoService(kp *KafkaProducer, va *VisionAPI) *PhotoService {
-	// Veritabanından en yüksek ID değerini al
-	highestID, err := GetHighestPhotoIDFromDB()
+	rankers, err := NewFeedRankers(cfg.Ranking)
 	if err != nil {
-		log.Printf("En yüksek ID alınamadı: %v", err)
-		highestID = 0
+		return nil, err
 	}
 
 	return &PhotoService{
-		kafkaProducer:  kp,
-		visionAPI:      va,
-		uploadedImages: make([]*UploadedImage, 0),
-		dbImages:       make([]*UploadedImage, 0),
-		lastImageID:    highestID,
-	}
+		kafkaProducer: kp,
+		visionAPI:     va,
+		moderation:    moderation,
+		locations:     locations,
+		emotions:      emotions,
+		rankers:       rankers,
+		feed:          feed,
+		batch:         batchLimits(cfg.Batch),
+	}, nil
 }
 
-// GetPhotosFromDB, veritabanından tüm fotoğrafları çeker.
-func GetPhotosFromDB() ([]*UploadedImage, error) {
-	rows, err := db.Query(`SELECT id, url, emotion, confidence, upload_time FROM photos`)
-	if err != nil {
-		log.Printf("Fotoğraflar alınamadı: %v", err)
-		return nil, err
-	}
-	defer rows.Close()
-
-	var dbImages []*UploadedImage
-	for rows.Next() {
-		var uploadedImage UploadedImage
-		var uploadTime time.Time
-
-		// FaceAnalysis dilimini oluştur
-		uploadedImage.FaceAnalysis = make([]*FaceAnalysis, 1)
-		uploadedImage.FaceAnalysis[0] = &FaceAnalysis{}
-		err := rows.Scan(&uploadedImage.Id, &uploadedImage.Url, &uploadedImage.FaceAnalysis[0].Emotion, &uploadedImage.FaceAnalysis[0].Confidence, &uploadTime)
-		if err != nil {
-			log.Printf("Fotoğraf alınamadı: %v", err)
-			return nil, err
-		}
-
-		// uploadTime'ı int64'e dönüştürür.
-		uploadedImage.UploadTime = uploadTime.Unix()
-
-		// Boş bir dilim oluştur
-		if uploadedImage.FaceAnalysis[0] == nil {
-			uploadedImage.FaceAnalysis[0] = &FaceAnalysis{}
-		}
+// GetPhotoByID, belirli bir ID'ye sahip fotoğrafı veritabanından çeker.
+func GetPhotoByID(ctx context.Context, id string) (*UploadedImage, error) {
+	row := db.QueryRowContext(ctx, `SELECT `+photoColumns+` FROM photos WHERE id = $1`, id)
+	return scanPhoto(row)
+}
 
-		dbImages = append(dbImages, &uploadedImage)
+// getPhoto, istemcinin verdiği ID'deki fotoğrafı çeker ve hatayı istemciye döndürülecek koda çevirir: geçersiz
+// ID InvalidArgument, bulunamayan fotoğraf NotFound, diğer veritabanı hataları Internal olur.
+func getPhoto(ctx context.Context, id string) (*UploadedImage, error) {
+	if id == "" {
+		return nil, status.Error(codes.InvalidArgument, "fotoğraf ID'si boş olamaz")
+	}
+	if _, err := strconv.ParseInt(id, 10, 64); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "geçersiz fotoğraf ID'si: %q", id)
 	}
 
-	return dbImages, nil
+	img, err := GetPhotoByID(ctx, id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, status.Errorf(codes.NotFound, "fotoğraf bulunamadı: %s", id)
+	}
+	if err != nil {
+		slog.ErrorContext(ctx, "Fotoğraf veritabanından alınamadı", "photo_id", id, "error", err)
+		return nil, status.Error(codes.Internal, "fotoğraf alınamadı")
+	}
+	return img, nil
 }
 
-// GetPhotoByID, belirli bir ID'ye sahip fotoğrafı veritabanından çeker.
-func GetPhotoByID(id string) (*UploadedImage, error) {
-	row := db.QueryRow("SELECT id, url, emotion, confidence, upload_time FROM photos WHERE id = $1", id)
-	return scanPhoto(row)
+// rowScanner, *sql.Row ve *sql.Rows türlerinin ortak Scan metodunu temsil eder.
+type rowScanner interface {
+	Scan(dest ...any) error
 }
 
 // scanPhoto, veritabanı satırındaki verileri *UploadedImage türündeki bir nesneye tarar.
-func scanPhoto(row *sql.Row) (*UploadedImage, error) {
+// photoColumns'tan sonra seçilen ek sütunlar extra hedeflerine taranır.
+func scanPhoto(row rowScanner, extra ...any) (*UploadedImage, error) {
 	var img UploadedImage
 	var emotion sql.NullString
 	var confidence sql.NullFloat64
 	var uploadTime time.Time
-
-	err := row.Scan(&img.Id, &img.Url, &emotion, &confidence, &uploadTime)
+	var moderationStatus string
+	var safeSearch []byte
+	var latitude, longitude sql.NullFloat64
+	var locationSource, locationName string
+	var capturedAt sql.NullTime
+	var analysisStatus string
+
+	dest := append([]any{&img.Id, &img.Url, &emotion, &confidence, &uploadTime, &img.OwnerId, &img.Caption,
+		&moderationStatus, &img.ModerationReason, &safeSearch,
+		&latitude, &longitude, &locationSource, &locationName, &img.EmotionRulesVersion,
+		&img.ImageWidth, &img.ImageHeight, &capturedAt, &img.AlbumId, &analysisStatus}, extra...)
+	err := row.Scan(dest...)
 	if err != nil {
 		return nil, err
 	}
 
+	img.ModerationStatus = moderationStatusFromDB(moderationStatus)
+	img.AnalysisStatus = analysisStatusFromDB(analysisStatus)
+	if capturedAt.Valid {
+		img.CapturedAt = capturedAt.Time.Unix()
+	}
+	if latitude.Valid && longitude.Valid {
+		img.Location = &Location{
+			Latitude:  latitude.Float64,
+			Longitude: longitude.Float64,
+			Source:    LocationSource(LocationSource_value["LOCATION_SOURCE_"+locationSource]),
+			Name:      locationName,
+		}
+	}
+	if safeSearch != nil {
+		img.SafeSearch = &SafeSearch{}
+		if err := json.Unmarshal(safeSearch, img.SafeSearch); err != nil {
+			return nil, err
+		}
+	}
+
 	// FaceAnalysis dilimini oluşturur.
 	img.FaceAnalysis = make([]*FaceAnalysis, 1)
 
@@ -132,158 +152,244 @@ func scanPhoto(row *sql.Row) (*UploadedImage, error) {
 	return &img, nil
 }
 
+// maxCaptureClockSkew, çekim zamanının sunucu saatinin ne kadar ilerisinde olabileceğidir. Kamera saatleri
+// genellikle yerel saatle ve saat dilimi bilgisi olmadan yazılır.
+const maxCaptureClockSkew = 24 * time.Hour
+
+// validateCapturedAt, istemcinin gönderdiği çekim zamanını doğrular. 0, çekim zamanı yok demektir.
+func validateCapturedAt(capturedAt int64) error {
+	if capturedAt < 0 {
+		return status.Error(codes.InvalidArgument, "çekim zamanı negatif olamaz")
+	}
+	if capturedAt > 0 && time.Unix(capturedAt, 0).After(now().Add(maxCaptureClockSkew)) {
+		return status.Error(codes.InvalidArgument, "çekim zamanı gelecekte olamaz")
+	}
+	return nil
+}
+
+// analysisError, Vision API hatasını istemciye döndürülecek hataya çevirir. Sınır, bütçe ve erişilemezlik
+// hataları istemci yeniden deneyebilsin diye, indirilemeyen ya da engellenen görüntü hataları da istemci
+// URL'yi düzeltebilsin diye olduğu gibi döner.
+func analysisError(err error) error {
+	switch status.Code(err) {
+	case codes.ResourceExhausted, codes.Unavailable, codes.InvalidArgument:
+		return err
+	}
+	return fmt.Errorf("Yüz analizi yapılırken hata oluştu: %v", err)
+}
+
 // UploadImage, yeni bir fotoğrafı sisteme yükleyen işlemi gerçekleştirir.
 func (s *PhotoService) UploadImage(ctx context.Context, image *UploadedImage) (*UploadedImage, error) {
+	// Kullanıcının yükleme sırasında verdiği etiketleri doğrular.
+	tags, err := normalizeTags(image.Tags)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateImageURL(image.Url); err != nil {
+		return nil, err
+	}
+	if err := validateClientLocation(image.Location); err != nil {
+		return nil, err
+	}
+	if err := validateCapturedAt(image.CapturedAt); err != nil {
+		return nil, err
+	}
 
-	// ID'yi bir artırarak yeni bir ID oluşturur.
-	s.lastImageID++
-	newImageID := s.lastImageID
-	// Yüz analizi sonuçlarını alır.
-	faceAnalysisResult, err := s.visionAPI.AnalyzeFaces(ctx, image.Url)
+	// Yüz analizi ve istenmişse etiket ve metin tespiti sonuçlarını alır.
+	// İstemci konum gönderdiyse yer işareti tespitine gerek yoktur.
+	opts := AnalyzeOptions{
+		DetectLabels: image.DetectLabels,
+		TextMode:     image.DetectText,
+		SafeSearch:   s.moderation.Enabled(),
+		Landmarks:    image.DetectLandmarks && image.Location == nil,
+		Refresh:      image.Refresh,
+	}
+	analysis, err := s.visionAPI.AnalyzeImage(ctx, image.Url, opts)
+	if isVisionUnavailable(err) && ctx.Err() == nil {
+		// Vision API'ye ulaşılamıyorsa fotoğraf reddedilmez; analiz kuyruğa alınır.
+		slog.WarnContext(ctx, "Vision API kullanılamıyor, fotoğraf analiz kuyruğuna alınıyor", "error", err)
+		return s.uploadPending(ctx, image, tags, opts)
+	}
 	if err != nil {
-		return nil, fmt.Errorf("Yüz analizi yapılırken hata oluştu: %v", err)
+		return nil, analysisError(err)
 	}
 
-	// Yüz analizi sonuçları diliminin boş olup olmadığını kontrol eder.
-	if len(faceAnalysisResult) == 0 {
-		return nil, fmt.Errorf("Yüz analizi sonuçları bulunamadı")
+	// Duygu etiketlerini yürürlükteki kurallarla belirler.
+	rulesVersion := s.emotions.ClassifyFaces(analysis.Faces)
+
+	// SafeSearch sonucuna göre denetim kararını verir. Reddedilen fotoğraf kaydedilmez.
+	moderationStatus, moderationReason := s.moderation.Evaluate(analysis.SafeSearch)
+	if moderationStatus == ModerationStatus_MODERATION_REJECTED {
+		slog.InfoContext(ctx, "Fotoğraf içerik denetiminde reddedildi", "url", image.Url, "reason", moderationReason)
+		return nil, status.Errorf(codes.InvalidArgument, "fotoğraf içerik politikası nedeniyle reddedildi: %s", moderationReason)
 	}
-	// Yüklenen fotoğrafı oluşturur.
+
+	// Yüklenen fotoğrafı oluşturur. ID, veritabanına eklenirken atanır. Yüz bulunmayan fotoğraflar
+	// (ekran görüntüleri, belgeler) duygusuz kaydedilir.
 	uploadedImage := &UploadedImage{
-		Id:  strconv.Itoa(newImageID),
-		Url: image.Url,
-		FaceAnalysis: []*FaceAnalysis{
-			{
-				Emotion:    faceAnalysisResult[0].Emotion,
-				Confidence: float32(faceAnalysisResult[0].Confidence),
-			},
-		},
-		UploadTime: now().Unix(),
+		Url:                 image.Url,
+		FaceAnalysis:        toProtoFaces(analysis.Faces),
+		EmotionRulesVersion: rulesVersion,
+		ImageWidth:          int32(analysis.Width),
+		ImageHeight:         int32(analysis.Height),
+		UploadTime:          now().Unix(),
+		Labels:              toProtoLabels(analysis.Labels),
+		Tags:                tags,
+		Text:                toProtoText(analysis.Text),
+		OwnerId:             clientIdentity(ctx),
+		Caption:             image.Caption,
+		CapturedAt:          image.CapturedAt,
+		AlbumId:             image.AlbumId,
+
+		ModerationStatus: moderationStatus,
+		ModerationReason: moderationReason,
+		SafeSearch:       analysis.SafeSearch,
+		Location:         resolveLocation(image.Location, analysis.Landmark),
 	}
-	s.uploadedImages = append(s.uploadedImages, uploadedImage)
 
 	// Veritabanına fotoğrafı ekler.
-	if err := InsertPhoto(uploadedImage); err != nil {
+	if err := InsertPhoto(ctx, uploadedImage); err != nil {
 		// Veritabanına ekleme hatası
-		log.Printf("Veritabanına fotoğraf eklenirken hata oluştu: %v", err)
+		slog.ErrorContext(ctx, "Veritabanına fotoğraf eklenirken hata oluştu", "error", err)
 		return nil, err
 	}
+	syncLocationIndex(ctx, s.locations, uploadedImage)
+
+	// Kafka'ya asenkron bir şekilde yükleme olayını gönderir.
+	s.publishEvent(ctx, imageUploadedEvent(uploadedImage))
 
 	return uploadedImage, nil
+}
 
-	// Kafka'ya asenkron bir şekilde Vision API için mesaj gönderir.
-	err = s.kafkaProducer.ProduceMessage("image-upload-topic", "Fotoğraf Yüklendi: "+uploadedImage.Id)
-	if err != nil {
-		log.Printf("Kafka'ya mesaj gönderirken hata oluştu: %v", err)
+// uploadPending, fotoğrafı analiz edilmeden bekleyen durumda kaydeder. Denetim kararı verilene kadar fotoğraf
+// akışta görünmez; analiz ProcessAnalysisQueue ile tamamlanır.
+func (s *PhotoService) uploadPending(ctx context.Context, image *UploadedImage, tags []string, opts AnalyzeOptions) (*UploadedImage, error) {
+	uploadedImage := &UploadedImage{
+		Url:        image.Url,
+		UploadTime: now().Unix(),
+		Tags:       tags,
+		OwnerId:    clientIdentity(ctx),
+		Caption:    image.Caption,
+		CapturedAt: image.CapturedAt,
+		AlbumId:    image.AlbumId,
+		Location:   resolveLocation(image.Location, nil),
+
+		ModerationStatus: ModerationStatus_MODERATION_PENDING,
+		AnalysisStatus:   AnalysisStatus_ANALYSIS_PENDING,
+	}
+
+	if err := InsertPendingPhoto(ctx, uploadedImage, opts); err != nil {
+		slog.ErrorContext(ctx, "Veritabanına fotoğraf eklenirken hata oluştu", "error", err)
+		return nil, err
 	}
+	syncLocationIndex(ctx, s.locations, uploadedImage)
+
+	s.publishEvent(ctx, imageUploadedEvent(uploadedImage))
 
 	return uploadedImage, nil
 }
 
-// GetImageDetail, belli bir fotoğrafın yüz analizi bilgilerini verir.
+// GetImageDetail, belli bir fotoğrafın kayıtlı analiz sonuçlarını ve ayrıntılarını verir. Refresh istenirse
+// yüzler Vision API ile yeniden analiz edilir ve kayıt güncellenir; yüzlerin kişi atamaları bu durumda kalkar.
+// Kaydı değiştirdiği için yeniden analizi yalnızca fotoğrafın sahibi isteyebilir.
 func (s *PhotoService) GetImageDetail(ctx context.Context, req *UploadedImage) (*UploadedImage, error) {
 	// Fotoğrafı veritabanından çeker.
-	dbImage, err := GetPhotoByID(req.Id)
+	dbImage, err := getPhoto(ctx, req.Id)
 	if err != nil {
-		return nil, fmt.Errorf("Fotoğraf bulunamadı: %v", err)
+		return nil, err
 	}
 
-	// Yüz analizi sonuçlarını alır.
-	faceAnalysisResult, err := s.visionAPI.AnalyzeFaces(ctx, dbImage.Url)
-	if err != nil {
-		return nil, fmt.Errorf("Yüz analizi yapılırken hata oluştu: %v", err)
+	if req.Refresh {
+		if err := authorizeOwner(ctx, dbImage.OwnerId); err != nil {
+			return nil, err
+		}
+		if err := s.refreshFaces(ctx, dbImage); err != nil {
+			return nil, err
+		}
 	}
 
-	// Yüz analizi sonuçları diliminin boş olup olmadığını kontrol eder.
-	if len(faceAnalysisResult) == 0 {
-		return nil, fmt.Errorf("Yüz analizi sonuçları bulunamadı")
+	// Kayıtlı yüzleri, etiketleri ve OCR metnini fotoğraf detayına ekler.
+	if err := loadImageDetails(ctx, []*UploadedImage{dbImage}); err != nil {
+		return nil, fmt.Errorf("Fotoğraf ayrıntıları alınamadı: %v", err)
 	}
+	if err := loadText(ctx, dbImage); err != nil {
+		return nil, fmt.Errorf("Fotoğraf metni alınamadı: %v", err)
+	}
+	return personsVisibleTo(clientIdentity(ctx), dbImage), nil
+}
 
-	// Yüz analizi sonuçlarını fotoğraf detayına ekler.
-	dbImage.FaceAnalysis = []*FaceAnalysis{
-		{
-			Emotion:    faceAnalysisResult[0].Emotion,
-			Confidence: float32(faceAnalysisResult[0].Confidence),
-		},
+// refreshFaces, fotoğrafın yüzlerini önbelleği atlayarak yeniden analiz eder ve yürürlükteki duygu
+// kurallarıyla kaydeder.
+func (s *PhotoService) refreshFaces(ctx context.Context, img *UploadedImage) error {
+	analysis, err := s.visionAPI.AnalyzeImage(ctx, img.Url, AnalyzeOptions{Refresh: true})
+	if err != nil {
+		return analysisError(err)
 	}
-	return dbImage, nil
+
+	img.EmotionRulesVersion = s.emotions.ClassifyFaces(analysis.Faces)
+	img.FaceAnalysis = toProtoFaces(analysis.Faces)
+	img.ImageWidth = int32(analysis.Width)
+	img.ImageHeight = int32(analysis.Height)
+	if err := UpdatePhotoFaces(ctx, img); err != nil {
+		return fmt.Errorf("Fotoğraf veritabanında güncellenemedi: %v", err)
+	}
+	return nil
 }
 
-// GetImageFeed, yüklenen fotoğrafları yüklenme tarihine ve analiz değerlerine göre sıralayarak sayfalandıran işlemi gerçekleştirir.
+// GetImageFeed, denetimden geçmiş fotoğrafları filtreleyip istenen sıralama stratejisiyle sayfalandırarak listeler.
 func (s *PhotoService) GetImageFeed(ctx context.Context, req *GetImageFeedRequest) (*GetImageFeedResponse, error) {
+	pageSize := clampPageSize(req.PageSize)
 
-	// Örnek bir sayfa büyüklüğü...
+	kind, ranker, err := s.rankers.Resolve(req.Ranking)
+	if err != nil {
+		return nil, err
+	}
 
-	pageSize := req.PageSize
-	if pageSize <= 0 {
-		pageSize = 10
+	feedFilter, err := newFeedFilter(req.Filter)
+	if err != nil {
+		return nil, err
 	}
 
-	// Örnek bir sayfa numarası belirler.
-	pageNumber := req.PageNumber
-	if pageNumber <= 0 {
-		pageNumber = 1
+	// Sayfa belirteci varsa onu, yoksa sayfa numarasını kullanır.
+	filter := filterDigest("feed", kind.String(), strconv.FormatInt(req.RandomSeed, 10), feedFilter.digest())
+	page, err := resolvePage(req.PageToken, req.PageNumber, pageSize, filter)
+	if err != nil {
+		return nil, err
 	}
-	// Yüklenen fotoğrafları veritabanından çeker.
-	var err error
-	s.dbImages, err = GetPhotosFromDB()
+	// Rastgele sıralamanın tohumu ilk sayfada belirlenir ve sonraki sayfalara belirteçle taşınır.
+	if kind == FeedRanking_FEED_RANKING_RANDOM && page.Seed == 0 {
+		page.Seed = req.RandomSeed
+		if page.Seed == 0 {
+			page.Seed = newRandomSeed()
+		}
+	}
+
+	images, scores, err := QueryFeed(ctx, feedQuery{Ranker: ranker, Filter: feedFilter, Page: page, Limit: pageSize + 1})
 	if err != nil {
 		return nil, fmt.Errorf("Veritabanından fotoğraflar alınamadı: %v", err)
 	}
 
-	// Yüklenen fotoğrafları yüklenme tarihine ve analiz değerlerine göre sıralar.
-	sort.Slice(s.dbImages, func(i, j int) bool {
-		// İlk olarak yüklenme tarihine göre sıralar.
-		timeI := time.Unix(s.dbImages[i].UploadTime, 0)
-		timeJ := time.Unix(s.dbImages[j].UploadTime, 0)
-
-		if timeI.Equal(timeJ) {
-			// Eğer yüklenme tarihleri aynıysa, analiz ortalamalarına göre sıralar.
-			avgEmotion1 := calculateAverageEmotion(s.dbImages[i].FaceAnalysis)
-			avgEmotion2 := calculateAverageEmotion(s.dbImages[j].FaceAnalysis)
-
-			return avgEmotion1 > avgEmotion2 // Büyükten küçüğe sıralar. (yüksek ortalama önce gelsin)
-		}
+	hasMore := len(images) > pageSize
+	if hasMore {
+		images = images[:pageSize]
+	}
+	if err := loadImageDetails(ctx, images); err != nil {
+		return nil, fmt.Errorf("Fotoğraf ayrıntıları alınamadı: %v", err)
+	}
 
-		// Eğer yüklenme tarihleri farklıysa, tarih sıralamasını kullanır.
-		return timeI.After(timeJ)
-	})
-	// Sayfalama hesaplamalarını yapar.
-	startIndex := int((pageNumber - 1) * pageSize)
-	endIndex := int(pageNumber * pageSize)
-
-	// Başlangıç ve bitiş indekslerini kontrol eder.
-	if startIndex < 0 {
-		startIndex = 0
-	}
-	if endIndex > len(s.dbImages) {
-		endIndex = len(s.dbImages)
-	}
-	// Sayfa boyunca olan fotoğrafları alır.
-	var pageImages []*Image
-	for _, img := range s.dbImages[startIndex:endIndex] {
-		if len(img.FaceAnalysis) > 0 {
-			pageImages = append(pageImages, &Image{
-				Id:  img.Id,
-				Url: img.Url,
-				FaceAnalysis: []*FaceAnalysis{
-					{Emotion: img.FaceAnalysis[0].Emotion, Confidence: img.FaceAnalysis[0].Confidence},
-				},
-				UploadTime: img.UploadTime,
-			})
-		} else {
-			pageImages = append(pageImages, &Image{
-				Id:           img.Id,
-				Url:          img.Url,
-				FaceAnalysis: nil,
-				UploadTime:   img.UploadTime,
-			})
+	// Sonraki sayfa, bu sayfanın son fotoğrafının puanından ve ID'sinden devam eder.
+	var next string
+	if hasMore {
+		next, err = nextFeedToken(page, images[pageSize-1], scores[pageSize-1])
+		if err != nil {
+			return nil, fmt.Errorf("Sayfa belirteci oluşturulamadı: %v", err)
 		}
 	}
 
 	// Sayfalama sonuçlarını oluşturur.
 	response := &GetImageFeedResponse{
-		Images: s.dbImages,
+		Images:        hideForeignPersons(ctx, images),
+		NextPageToken: next,
 	}
 
 	return response, nil
@@ -291,49 +397,89 @@ func (s *PhotoService) GetImageFeed(ctx context.Context, req *GetImageFeedReques
 
 // UpdateImageDetail, fotoğraf detaylarını günceller.
 func (s *PhotoService) UpdateImageDetail(ctx context.Context, req *UploadedImage) (*UploadedImage, error) {
+	// İstemcinin gönderdiği alanları Vision API çağrısından önce doğrular.
+	if err := validateImageURL(req.Url); err != nil {
+		return nil, err
+	}
+	if err := validateClientLocation(req.Location); err != nil {
+		return nil, err
+	}
+	if err := validateCapturedAt(req.CapturedAt); err != nil {
+		return nil, err
+	}
+
 	// Fotoğrafı veritabanından çeker.
-	dbImage, err := GetPhotoByID(req.Id)
+	dbImage, err := getPhoto(ctx, req.Id)
 	if err != nil {
-		return nil, fmt.Errorf("Fotoğraf bulunamadı: %v", err)
+		return nil, err
 	}
 
-	// Yeni URL için Vision API'yi kullanarak yüz analizi yapar.
-	faceAnalysisResult, err := s.visionAPI.AnalyzeFaces(ctx, req.Url)
+	// Yeni URL için Vision API'yi kullanarak yüz analizi ve istenmişse etiket ve metin tespiti yapar.
+	analysis, err := s.visionAPI.AnalyzeImage(ctx, req.Url, AnalyzeOptions{
+		DetectLabels: req.DetectLabels,
+		TextMode:     req.DetectText,
+		SafeSearch:   s.moderation.Enabled(),
+		Landmarks:    req.DetectLandmarks && req.Location == nil,
+		Refresh:      req.Refresh,
+	})
 	if err != nil {
-		return nil, fmt.Errorf("Yüz analizi yapılırken hata oluştu: %v", err)
+		return nil, analysisError(err)
 	}
 
-	// Yüz analizi sonuçları diliminin boş olup olmadığını kontrol eder.
-	if len(faceAnalysisResult) == 0 {
-		return nil, fmt.Errorf("Yüz analizi sonuçları bulunamadı")
+	// Yeni içerik de denetimden geçer; reddedilirse mevcut fotoğraf değiştirilmez.
+	moderationStatus, moderationReason := s.moderation.Evaluate(analysis.SafeSearch)
+	if moderationStatus == ModerationStatus_MODERATION_REJECTED {
+		slog.InfoContext(ctx, "Fotoğraf içerik denetiminde reddedildi", "url", req.Url, "reason", moderationReason)
+		return nil, status.Errorf(codes.InvalidArgument, "fotoğraf içerik politikası nedeniyle reddedildi: %s", moderationReason)
 	}
 
+	location := resolveLocation(req.Location, analysis.Landmark)
+
 	// Güncelleme işlemi
 	dbImage.Url = req.Url
-	dbImage.FaceAnalysis = []*FaceAnalysis{
-		{
-			Emotion:    faceAnalysisResult[0].Emotion,
-			Confidence: float32(faceAnalysisResult[0].Confidence),
-		},
+	dbImage.EmotionRulesVersion = s.emotions.ClassifyFaces(analysis.Faces)
+	dbImage.FaceAnalysis = toProtoFaces(analysis.Faces)
+	dbImage.ImageWidth = int32(analysis.Width)
+	dbImage.ImageHeight = int32(analysis.Height)
+	// Eski URL'ye ait etiketler ve metin geçersiz olduğundan yeni sonuçlarla değiştirilir.
+	dbImage.Labels = toProtoLabels(analysis.Labels)
+	dbImage.Text = toProtoText(analysis.Text)
+	dbImage.ModerationStatus = moderationStatus
+	dbImage.ModerationReason = moderationReason
+	dbImage.SafeSearch = analysis.SafeSearch
+	dbImage.AnalysisStatus = AnalysisStatus_ANALYSIS_COMPLETE
+	if req.Caption != "" {
+		dbImage.Caption = req.Caption
+	}
+	if req.CapturedAt != 0 {
+		dbImage.CapturedAt = req.CapturedAt
+	}
+	if req.AlbumId != "" {
+		dbImage.AlbumId = req.AlbumId
+	}
+	// Yer işaretinden bulunan konum eski URL'ye aittir; istemcinin gönderdiği konum ise yeni konum gelmedikçe korunur.
+	if location != nil {
+		dbImage.Location = location
+	} else if dbImage.Location != nil && dbImage.Location.Source == LocationSource_LOCATION_SOURCE_LANDMARK {
+		dbImage.Location = nil
 	}
-	dbImage.UploadTime = time.Now().Unix()
 
 	// UpdatePhoto fonksiyonunu kullanarak veritabanında güncelleme yapar.
-	err = UpdatePhoto(dbImage)
+	err = UpdatePhoto(ctx, dbImage)
 	if err != nil {
 		return nil, fmt.Errorf("Fotoğraf veritabanında güncellenemedi: %v", err)
 	}
-
-	return dbImage, nil
-}
-
-// calculateAverageEmotion, yüz analizi sonuçlarının ortalamasını hesaplar.
-func calculateAverageEmotion(faceAnalysis []*FaceAnalysis) float32 {
-
-	var totalConfidence float32
-	for _, analysis := range faceAnalysis {
-		totalConfidence += analysis.Confidence
+	syncLocationIndex(ctx, s.locations, dbImage)
+	s.publishEvent(ctx, &EventEnvelope_ImageUpdated{ImageUpdated: &ImageUpdated{
+		PhotoId:          dbImage.Id,
+		Url:              dbImage.Url,
+		ModerationStatus: dbImage.ModerationStatus,
+	}})
+
+	// Kullanıcı etiketleri URL değişse de korunur.
+	if err := loadImageDetails(ctx, []*UploadedImage{dbImage}); err != nil {
+		return nil, fmt.Errorf("Fotoğraf ayrıntıları alınamadı: %v", err)
 	}
 
-	return totalConfidence / float32(len(faceAnalysis))
+	return dbImage, nil
 }
@@ -0,0 +1,144 @@
+package photo
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	maxTagLength    = 64 // Bir etiketin en fazla karakter sayısı
+	maxTagsPerImage = 50 // Tek istekte eklenebilecek en fazla etiket sayısı
+)
+
+// normalizeTags, kullanıcı etiketlerini küçük harfe çevirir, boşlukları kırpar ve tekrarları atar.
+func normalizeTags(tags []string) ([]string, error) {
+	if len(tags) > maxTagsPerImage {
+		return nil, status.Errorf(codes.InvalidArgument, "en fazla %d etiket gönderilebilir", maxTagsPerImage)
+	}
+
+	seen := make(map[string]bool, len(tags))
+	normalized := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		tag = strings.ToLower(strings.TrimSpace(tag))
+		if tag == "" {
+			return nil, status.Error(codes.InvalidArgument, "etiket boş olamaz")
+		}
+		if utf8.RuneCountInString(tag) > maxTagLength {
+			return nil, status.Errorf(codes.InvalidArgument, "etiket en fazla %d karakter olabilir: %q", maxTagLength, tag)
+		}
+		if seen[tag] {
+			continue
+		}
+		seen[tag] = true
+		normalized = append(normalized, tag)
+	}
+	return normalized, nil
+}
+
+// toProtoLabels, Vision API etiket sonuçlarını proto mesajlarına dönüştürür.
+func toProtoLabels(labels []*LabelResult) []*Label {
+	if len(labels) == 0 {
+		return nil
+	}
+
+	result := make([]*Label, 0, len(labels))
+	for _, label := range labels {
+		result = append(result, &Label{
+			Description: label.Description,
+			Score:       float32(label.Score),
+			Mid:         label.Mid,
+		})
+	}
+	return result
+}
+
+// AddImageTags, bir fotoğrafa kullanıcı etiketleri ekler ve güncel fotoğrafı döndürür.
+func (s *PhotoService) AddImageTags(ctx context.Context, req *ImageTagsRequest) (*UploadedImage, error) {
+	tags, err := normalizeTags(req.Tags)
+	if err != nil {
+		return nil, err
+	}
+	if len(tags) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "en az bir etiket gönderilmelidir")
+	}
+
+	dbImage, err := getTaggablePhoto(ctx, req.ImageId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := AddTags(ctx, dbImage.Id, tags); err != nil {
+		return nil, fmt.Errorf("Fotoğraf etiketleri eklenemedi: %v", err)
+	}
+
+	if err := loadImageDetails(ctx, []*UploadedImage{dbImage}); err != nil {
+		return nil, fmt.Errorf("Fotoğraf ayrıntıları alınamadı: %v", err)
+	}
+	return dbImage, nil
+}
+
+// RemoveImageTags, bir fotoğraftan kullanıcı etiketlerini kaldırır ve güncel fotoğrafı döndürür.
+// Vision API etiketleri bu işlemden etkilenmez.
+func (s *PhotoService) RemoveImageTags(ctx context.Context, req *ImageTagsRequest) (*UploadedImage, error) {
+	tags, err := normalizeTags(req.Tags)
+	if err != nil {
+		return nil, err
+	}
+	if len(tags) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "en az bir etiket gönderilmelidir")
+	}
+
+	dbImage, err := getTaggablePhoto(ctx, req.ImageId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := RemoveTags(ctx, dbImage.Id, tags); err != nil {
+		return nil, fmt.Errorf("Fotoğraf etiketleri silinemedi: %v", err)
+	}
+
+	if err := loadImageDetails(ctx, []*UploadedImage{dbImage}); err != nil {
+		return nil, fmt.Errorf("Fotoğraf ayrıntıları alınamadı: %v", err)
+	}
+	return dbImage, nil
+}
+
+// ListImagesByTag, kullanıcı etiketi veya Vision API etiketi eşleşen fotoğrafları sayfalayarak listeler.
+func (s *PhotoService) ListImagesByTag(ctx context.Context, req *ListImagesByTagRequest) (*GetImageFeedResponse, error) {
+	tag := strings.ToLower(strings.TrimSpace(req.Tag))
+	if tag == "" {
+		return nil, status.Error(codes.InvalidArgument, "etiket boş olamaz")
+	}
+
+	pageSize := clampPageSize(req.PageSize)
+	pageNumber := req.PageNumber
+	if pageNumber <= 0 {
+		pageNumber = 1
+	}
+
+	images, err := GetPhotosByTag(ctx, tag, pageSize, int(pageNumber-1)*pageSize)
+	if err != nil {
+		return nil, fmt.Errorf("Veritabanından fotoğraflar alınamadı: %v", err)
+	}
+	images = hideForeignPersons(ctx, images)
+
+	return &GetImageFeedResponse{Images: images}, nil
+}
+
+// getTaggablePhoto, etiket işlemleri için fotoğrafı çeker ve bulunamazsa NotFound döndürür. Kullanıcı
+// etiketlerini yalnızca fotoğrafın sahibi değiştirebilir; sahip bağlantıdan belirlenir.
+func getTaggablePhoto(ctx context.Context, id string) (*UploadedImage, error) {
+	dbImage, err := getPhoto(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := authorizeOwner(ctx, dbImage.OwnerId); err != nil {
+		return nil, err
+	}
+	return dbImage, nil
+}
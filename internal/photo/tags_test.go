@@ -0,0 +1,43 @@
+package photo
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestNormalizeTags(t *testing.T) {
+	tests := []struct {
+		name     string
+		tags     []string
+		want     []string
+		wantCode codes.Code
+	}{
+		{"küçük harf ve kırpma", []string{" Deniz ", "YAZ"}, []string{"deniz", "yaz"}, codes.OK},
+		{"tekrarlar atılır", []string{"deniz", "Deniz", "yaz"}, []string{"deniz", "yaz"}, codes.OK},
+		{"boş etiket", []string{"deniz", "  "}, nil, codes.InvalidArgument},
+		{"uzun etiket", []string{strings.Repeat("ş", maxTagLength+1)}, nil, codes.InvalidArgument},
+		{"çok fazla etiket", make([]string, maxTagsPerImage+1), nil, codes.InvalidArgument},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := normalizeTags(tt.tags)
+			if code := status.Code(err); code != tt.wantCode {
+				t.Fatalf("normalizeTags hatası = %v, want %s", err, tt.wantCode)
+			}
+			if err == nil && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("normalizeTags = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetTaggablePhotoRejectsMalformedID(t *testing.T) {
+	// Sayı olmayan ID veritabanına gitmeden reddedilir.
+	if _, err := getTaggablePhoto(peerContext("203.0.113.7"), "abc"); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("getTaggablePhoto = %v, want InvalidArgument", err)
+	}
+}
@@ -0,0 +1,158 @@
+package photo
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"myphotoapp/config"
+
+	"github.com/confluentinc/confluent-kafka-go/kafka"
+	"github.com/jackc/pgx/v4"
+	"go.opentelemetry.io/otel"
+	otelcodes "go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.24.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const defaultServiceName = "myphotoapp"
+
+// tracer, paketin oluşturduğu span'lerin kaynağıdır. InitTracing çağrılmadıysa span'ler kaydedilmez.
+var tracer = otel.Tracer("myphotoapp/internal/photo")
+
+// InitTracing, yapılandırılan dışa aktarıcıyla genel izleme sağlayıcısını kurar ve kapanışta bekleyen
+// span'leri gönderecek fonksiyonu döndürür. Dışa aktarıcı "none" ya da boşsa izleme kapalıdır; bağlam yine de
+// yayıldığından gelen istekteki iz aşağı akışa aktarılır.
+func InitTracing(ctx context.Context, cfg config.TracingConfig) (func(context.Context) error, error) {
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+
+	var exporter sdktrace.SpanExporter
+	var err error
+	switch cfg.Exporter {
+	case "", "none":
+		return func(context.Context) error { return nil }, nil
+	case "otlp":
+		opts := []otlptracegrpc.Option{}
+		if cfg.Endpoint != "" {
+			opts = append(opts, otlptracegrpc.WithEndpoint(cfg.Endpoint))
+		}
+		if cfg.Insecure {
+			opts = append(opts, otlptracegrpc.WithInsecure())
+		}
+		exporter, err = otlptracegrpc.New(ctx, opts...)
+	case "stdout":
+		exporter, err = stdouttrace.New(stdouttrace.WithWriter(os.Stdout))
+	default:
+		return nil, fmt.Errorf("bilinmeyen izleme dışa aktarıcısı: %q", cfg.Exporter)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("izleme dışa aktarıcısı oluşturulamadı: %v", err)
+	}
+
+	serviceName := cfg.ServiceName
+	if serviceName == "" {
+		serviceName = defaultServiceName
+	}
+	res, err := resource.Merge(resource.Default(),
+		resource.NewWithAttributes(semconv.SchemaURL, semconv.ServiceName(serviceName)))
+	if err != nil {
+		return nil, err
+	}
+
+	sampler := sdktrace.AlwaysSample()
+	if cfg.SampleRatio > 0 && cfg.SampleRatio < 1 {
+		sampler = sdktrace.TraceIDRatioBased(cfg.SampleRatio)
+	}
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+		sdktrace.WithSampler(sdktrace.ParentBased(sampler)),
+	)
+	otel.SetTracerProvider(provider)
+	return provider.Shutdown, nil
+}
+
+// endSpan, span'i kapatır ve hata varsa span'e kaydeder.
+func endSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelcodes.Error, err.Error())
+	}
+	span.End()
+}
+
+// dbQueryTracer, pgx sürücüsünün her komut ve sorgu bittiğinde yazdığı kaydı, komutun başladığı ana geri
+// tarihlenmiş bir span olarak kaydeder. Veritabanı izleri yalnızca bu yolla oluşur; bağlamı taşımayan
+// (Context'siz) çağrılar izde görünmez. Sorgu parametreleri kişisel veri içerebileceğinden span'e yazılmaz. Arka
+// plan döngülerinin her yoklaması ayrı bir iz oluşturmasın diye yalnızca etkin bir span'in altındaki sorgular
+// kaydedilir.
+type dbQueryTracer struct{}
+
+func (dbQueryTracer) Log(ctx context.Context, level pgx.LogLevel, msg string, data map[string]interface{}) {
+	query, ok := data["sql"].(string)
+	if !ok || !trace.SpanFromContext(ctx).SpanContext().IsValid() {
+		return
+	}
+	elapsed, _ := data["time"].(time.Duration)
+	end := time.Now()
+
+	operation := dbOperation(query)
+	_, span := tracer.Start(ctx, "db "+operation,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithTimestamp(end.Add(-elapsed)),
+		trace.WithAttributes(semconv.DBSystemPostgreSQL, semconv.DBOperation(operation), semconv.DBStatement(query)))
+	if err, _ := data["err"].(error); err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelcodes.Error, err.Error())
+	}
+	span.End(trace.WithTimestamp(end))
+}
+
+// dbOperation, SQL metninin ilk anahtar sözcüğünü (SELECT, INSERT, ...) döndürür.
+func dbOperation(query string) string {
+	fields := strings.Fields(query)
+	if len(fields) == 0 {
+		return "QUERY"
+	}
+	return strings.ToUpper(fields[0])
+}
+
+// kafkaHeaderCarrier, iz bağlamını Kafka mesaj başlıklarına yazar. Bu depoda Kafka tüketicisi olmadığından iz
+// şimdilik üretici span'inde biter; olayları tüketen servis, bağlamı aynı başlıklardan okuyarak izi sürdürmelidir.
+type kafkaHeaderCarrier struct {
+	headers *[]kafka.Header
+}
+
+func (c kafkaHeaderCarrier) Get(key string) string {
+	for _, h := range *c.headers {
+		if h.Key == key {
+			return string(h.Value)
+		}
+	}
+	return ""
+}
+
+func (c kafkaHeaderCarrier) Set(key, value string) {
+	for i, h := range *c.headers {
+		if h.Key == key {
+			(*c.headers)[i].Value = []byte(value)
+			return
+		}
+	}
+	*c.headers = append(*c.headers, kafka.Header{Key: key, Value: []byte(value)})
+}
+
+func (c kafkaHeaderCarrier) Keys() []string {
+	keys := make([]string, len(*c.headers))
+	for i, h := range *c.headers {
+		keys[i] = h.Key
+	}
+	return keys
+}
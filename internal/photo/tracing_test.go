@@ -0,0 +1,77 @@
+package photo
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/confluentinc/confluent-kafka-go/kafka"
+	"github.com/jackc/pgx/v4"
+	otelcodes "go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// recordSpans, paketin span'lerini test boyunca biten span'leri toplayan bir sağlayıcıdan üretir.
+func recordSpans(t *testing.T) *tracetest.SpanRecorder {
+	t.Helper()
+	recorder := tracetest.NewSpanRecorder()
+	orig := tracer
+	tracer = sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)).Tracer("myphotoapp/internal/photo")
+	t.Cleanup(func() { tracer = orig })
+	return recorder
+}
+
+func TestDBQueryTracer(t *testing.T) {
+	recorder := recordSpans(t)
+
+	// Üst span'i olmayan sorgular kaydedilmez.
+	dbQueryTracer{}.Log(context.Background(), pgx.LogLevelInfo, "Query",
+		map[string]interface{}{"sql": "SELECT 1", "time": time.Millisecond})
+
+	ctx, parent := tracer.Start(context.Background(), "istek")
+	dbQueryTracer{}.Log(ctx, pgx.LogLevelInfo, "Exec",
+		map[string]interface{}{"sql": "\n\t\tupdate photos SET caption = $2", "time": 40 * time.Millisecond})
+	dbQueryTracer{}.Log(ctx, pgx.LogLevelError, "Query",
+		map[string]interface{}{"sql": "SELECT * FROM missing", "err": errors.New("relation does not exist")})
+	dbQueryTracer{}.Log(ctx, pgx.LogLevelInfo, "closed connection", nil)
+	parent.End()
+
+	spans := recorder.Ended()
+	if len(spans) != 3 {
+		t.Fatalf("%d span kaydedildi, want 3 (iki sorgu ve üst span)", len(spans))
+	}
+	update, failed := spans[0], spans[1]
+	if update.Name() != "db UPDATE" || update.Parent().SpanID() != parent.SpanContext().SpanID() {
+		t.Errorf("span = %s (üst %s), want isteğin altında db UPDATE", update.Name(), update.Parent().SpanID())
+	}
+	if d := update.EndTime().Sub(update.StartTime()); d != 40*time.Millisecond {
+		t.Errorf("span süresi = %v, want 40ms", d)
+	}
+	if failed.Name() != "db SELECT" || failed.Status().Code != otelcodes.Error {
+		t.Errorf("başarısız sorgu span'i = %s %v, want db SELECT Error", failed.Name(), failed.Status())
+	}
+}
+
+func TestKafkaHeaderCarrierInjectsTrace(t *testing.T) {
+	recordSpans(t)
+	propagator := propagation.TraceContext{}
+
+	ctx, span := tracer.Start(context.Background(), "istek")
+	defer span.End()
+
+	msg := &kafka.Message{Headers: []kafka.Header{{Key: "traceparent", Value: []byte("eski")}}}
+	propagator.Inject(ctx, kafkaHeaderCarrier{headers: &msg.Headers})
+	if len(msg.Headers) != 1 {
+		t.Fatalf("başlıklar = %v, want tek traceparent", msg.Headers)
+	}
+
+	// Başlıkları okuyan bir tüketici aynı izi sürdürebilmelidir.
+	got := propagator.Extract(context.Background(), kafkaHeaderCarrier{headers: &msg.Headers})
+	if id := trace.SpanContextFromContext(got).TraceID(); id != span.SpanContext().TraceID() {
+		t.Errorf("başlıktaki iz = %s, want %s", id, span.SpanContext().TraceID())
+	}
+}
@@ -2,7 +2,11 @@ package photo
 
 import (
 	"context"
-	"log"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"myphotoapp/config"
 
 	vision "cloud.google.com/go/vision/apiv1"
 	"cloud.google.com/go/vision/v2/apiv1/visionpb"
@@ -11,117 +15,426 @@ import (
 
 // VisionAPI, görüntü analizi işlemlerini yöneten bir yapıdır.
 type VisionAPI struct {
-	client *vision.ImageAnnotatorClient
+	client  *vision.ImageAnnotatorClient
+	fetcher *ImageFetcher
+	cache   *VisionCache // nil ise her çağrı Vision API'ye gider
+	limits  *visionLimits
+	breaker *CircuitBreaker
+	retry   visionRetryPolicy
 }
 
-// NewVisionAPI, yeni bir VisionAPI örneği oluşturur.
-func NewVisionAPI(ctx context.Context, credentialsFile string) (*VisionAPI, error) {
-	client, err := vision.NewImageAnnotatorClient(ctx, option.WithCredentialsFile(credentialsFile))
+// NewVisionAPI, yeni bir VisionAPI örneği oluşturur. Önbellek, eşzamanlılık sınırı, bütçeler ve görüntü
+// indirme ayarları yapılandırmadan alınır.
+func NewVisionAPI(ctx context.Context, credentialsFile string, cfg config.VisionConfig) (*VisionAPI, error) {
+	fetcher, err := NewImageFetcher(cfg.Fetch)
 	if err != nil {
-		log.Fatalf("Vision API istemcisi oluşturulamadı: %v", err)
 		return nil, err
 	}
 
-	return &VisionAPI{client: client}, nil
+	client, err := vision.NewImageAnnotatorClient(ctx, option.WithCredentialsFile(credentialsFile))
+	if err != nil {
+		return nil, fmt.Errorf("Vision API istemcisi oluşturulamadı: %w", err)
+	}
+
+	return &VisionAPI{
+		client:  client,
+		fetcher: fetcher,
+		cache:   NewVisionCache(cfg.Cache),
+		limits:  newVisionLimits(cfg),
+		breaker: newCircuitBreaker(cfg.Breaker),
+		retry:   newVisionRetryPolicy(cfg),
+	}, nil
+}
+
+// AnalyzeOptions, AnalyzeImage çağrısında yüz tespitine ek olarak istenecek özellikleri belirler.
+type AnalyzeOptions struct {
+	DetectLabels bool              // LABEL_DETECTION özelliğini ister
+	MaxLabels    int32             // Döndürülecek en fazla etiket sayısı (0 ise varsayılan)
+	TextMode     TextDetectionMode // İstenecek OCR özelliği (TEXT_DETECTION_NONE ise istenmez)
+	SafeSearch   bool              // SAFE_SEARCH_DETECTION özelliğini ister
+	Landmarks    bool              // LANDMARK_DETECTION özelliğini ister
+	Refresh      bool              // Önbellekteki sonucu kullanmadan yeniden analiz eder
+}
+
+// defaultMaxLabels, MaxLabels belirtilmediğinde istenecek etiket sayısıdır.
+const defaultMaxLabels = 10
+
+// ImageAnalysisResult, tek bir AnnotateImage çağrısının işlenmiş sonuçlarını tutar.
+type ImageAnalysisResult struct {
+	Faces      []*FaceAnalysisResult
+	Labels     []*LabelResult
+	Text       *TextResult     // OCR istenmediyse veya metin bulunamadıysa nil
+	SafeSearch *SafeSearch     // SafeSearch istenmediyse veya sonuç dönmediyse nil
+	Landmark   *LandmarkResult // En yüksek puanlı, koordinatı olan yer işareti; yoksa nil
+	Width      int             // Görüntü genişliği, piksel; bulunamadıysa 0
+	Height     int             // Görüntü yüksekliği, piksel; bulunamadıysa 0
+}
+
+// LandmarkResult, Vision API'nin tanıdığı bir yer işaretini ve koordinatlarını temsil eder.
+type LandmarkResult struct {
+	Name      string
+	Score     float64
+	Latitude  float64
+	Longitude float64
+}
+
+// LabelResult, Vision API'nin tespit ettiği bir etiketi temsil eder.
+type LabelResult struct {
+	Description string  // Etiket adı (örneğin, "Dog", "Beach")
+	Score       float64 // Etiketin güven puanı (0-1)
+	Mid         string  // Knowledge Graph kimliği
+}
+
+// TextResult, OCR ile tespit edilen metni temsil eder.
+type TextResult struct {
+	FullText string             // Görüntüdeki tüm metin, okuma sırasıyla
+	Language string             // Baskın dilin BCP-47 kodu (örneğin, "tr")
+	Blocks   []*TextBlockResult // Sayfa sırasıyla metin blokları
+}
+
+// TextBlockResult, bir metin bloğunu ve piksel cinsinden çevreleyen çokgenini temsil eder.
+type TextBlockResult struct {
+	Text        string
+	Confidence  float64
+	BoundingBox []*Vertex
 }
 
 // AnalyzeFaces, Vision API kullanarak bir görüntüdeki yüzleri analiz eder.
 func (v *VisionAPI) AnalyzeFaces(ctx context.Context, imageURI string) ([]*FaceAnalysisResult, error) {
-	// Vision API kullanarak yüz analizi işlemini burada gerçekleştirinr.
-	annotations, err := v.client.AnnotateImage(ctx, &visionpb.AnnotateImageRequest{
-		Image: &visionpb.Image{
-			Source: &visionpb.ImageSource{
-				ImageUri: imageURI,
-			},
+	result, err := v.AnalyzeImage(ctx, imageURI, AnalyzeOptions{})
+	if err != nil {
+		return nil, err
+	}
+	return result.Faces, nil
+}
+
+// AnalyzeImage, Vision API kullanarak bir görüntüdeki yüzleri ve istenirse etiketleri tek çağrıda analiz eder.
+// Görüntü sunucu tarafından güvenli biçimde indirilir ve Vision API'ye içerik olarak gönderilir. Önbellek
+// varsa aynı içerik ve özellikler için önceki sonuç döndürülür; Refresh ile yeniden analiz edilen sonuç
+// önbelleğe yazılır.
+func (v *VisionAPI) AnalyzeImage(ctx context.Context, imageURI string, opts AnalyzeOptions) (*ImageAnalysisResult, error) {
+	if v.cache == nil {
+		return v.fetchAndAnalyze(ctx, imageURI, opts)
+	}
+
+	// Sunucu ETag veriyorsa anahtar URL ve ETag'den üretilir ve önbellekteki sonuç için görüntü indirilmez.
+	// Vermiyorsa anahtar indirilen içerikten üretilir ve aynı içerik analizde yeniden kullanılır.
+	var img *FetchedImage
+	etag := v.fetcher.ETag(ctx, imageURI)
+	fingerprint := etagFingerprint(imageURI, etag)
+	if etag == "" {
+		var err error
+		if img, err = v.fetcher.Fetch(ctx, imageURI); err != nil {
+			return nil, err
+		}
+		fingerprint = contentFingerprint(img.Content)
+	}
+	key := visionCacheKey(fingerprint, opts)
+	if !opts.Refresh {
+		if result, ok := v.cache.Get(ctx, key); ok {
+			return result, nil
+		}
+	}
+
+	if img == nil {
+		var err error
+		if img, err = v.fetcher.Fetch(ctx, imageURI); err != nil {
+			return nil, err
+		}
+		// Görüntü HEAD isteğinden sonra değiştiyse sonuç eski ETag'in anahtarıyla değil, içerikle saklanır.
+		if img.ETag != etag {
+			key = visionCacheKey(contentFingerprint(img.Content), opts)
+		}
+	}
+
+	result, err := v.analyze(ctx, img, opts)
+	if err != nil {
+		return nil, err
+	}
+	v.cache.Put(ctx, key, result)
+	return result, nil
+}
+
+// fetchAndAnalyze, görüntüyü sunucu tarafında güvenli biçimde indirir ve Vision API'ye içerik olarak gönderir.
+// Vision API'ye URL verilmez; böylece görüntü, sunucunun uyguladığı adres ve boyut sınırlarıyla alınır.
+func (v *VisionAPI) fetchAndAnalyze(ctx context.Context, imageURI string, opts AnalyzeOptions) (*ImageAnalysisResult, error) {
+	img, err := v.fetcher.Fetch(ctx, imageURI)
+	if err != nil {
+		return nil, err
+	}
+	return v.analyze(ctx, img, opts)
+}
+
+// analyze, indirilmiş görüntüyü önbelleğe bakmadan Vision API ile analiz eder.
+func (v *VisionAPI) analyze(ctx context.Context, img *FetchedImage, opts AnalyzeOptions) (*ImageAnalysisResult, error) {
+	features := []*visionpb.Feature{
+		{
+			Type: visionpb.Feature_FACE_DETECTION,
 		},
-		Features: []*visionpb.Feature{
-			{
-				Type: visionpb.Feature_FACE_DETECTION,
-			},
+	}
+	if opts.DetectLabels {
+		maxLabels := opts.MaxLabels
+		if maxLabels <= 0 {
+			maxLabels = defaultMaxLabels
+		}
+		features = append(features, &visionpb.Feature{
+			Type:       visionpb.Feature_LABEL_DETECTION,
+			MaxResults: maxLabels,
+		})
+	}
+	switch opts.TextMode {
+	case TextDetectionMode_TEXT_DETECTION_SPARSE:
+		features = append(features, &visionpb.Feature{Type: visionpb.Feature_TEXT_DETECTION})
+	case TextDetectionMode_TEXT_DETECTION_DOCUMENT:
+		features = append(features, &visionpb.Feature{Type: visionpb.Feature_DOCUMENT_TEXT_DETECTION})
+	}
+	if opts.SafeSearch {
+		features = append(features, &visionpb.Feature{Type: visionpb.Feature_SAFE_SEARCH_DETECTION})
+	}
+	if opts.Landmarks {
+		features = append(features, &visionpb.Feature{Type: visionpb.Feature_LANDMARK_DETECTION, MaxResults: 1})
+	}
+
+	// Her özellik bir birim sayılır ve kiracının günlük bütçesinden düşülür.
+	release, err := v.limits.acquire(ctx, len(features))
+	if err != nil {
+		return nil, err
+	}
+
+	// Vision API kullanarak görüntü analizi işlemini burada gerçekleştirir.
+	annotations, err := v.annotate(ctx, &visionpb.AnnotateImageRequest{
+		Image: &visionpb.Image{
+			Content: img.Content,
 		},
+		Features: features,
 	})
+	release(err == nil)
 	if err != nil {
-		log.Printf("Yüz analizi başarısız: %v", err)
+		slog.ErrorContext(ctx, "Görüntü analizi başarısız", "error", err)
 		return nil, err
 	}
+	facesPerImage.Observe(float64(len(annotations.FaceAnnotations)))
 
-	// Yüz analizi sonuçlarını tutmak için bir dilim oluştur.
-	var results []*FaceAnalysisResult
+	result := &ImageAnalysisResult{}
+
+	// Yüz koordinatlarını normalize etmek için görüntü boyutlarını bulur. Boyutlar alınamazsa analiz
+	// geometri olmadan sürer.
+	if len(annotations.FaceAnnotations) > 0 {
+		result.Width, result.Height, err = imageDimensions(img.Content, annotations)
+		if err != nil {
+			slog.WarnContext(ctx, "Görüntü boyutları alınamadı, yüz geometrisi kaydedilmeyecek", "error", err)
+		}
+	}
 
 	// Tüm yüzleri döngü ile işler.
 	for _, faceAnnotation := range annotations.FaceAnnotations {
-		// Yüz analizi sonuçlarına göre hissiyatı ve güvenilirlik puanını belirler.
-		emotion := determineEmotion(faceAnnotation)
-		confidence := calculateConfidence(faceAnnotation)
+		// Baskın duyguyu ve güvenilirliğini tüm duygu puanlarından türetir.
+		scores := faceScores(faceAnnotation)
+		emotion, confidence := scores.dominantEmotion()
 
-		// Her bir yüz için bir FaceAnalysisResult oluşturur.
-		result := &FaceAnalysisResult{
-			Emotion:    emotion,
-			Confidence: confidence,
+		var geometry *FaceGeometry
+		if result.Width > 0 && result.Height > 0 {
+			geometry = faceGeometry(faceAnnotation, result.Width, result.Height)
 		}
 
-		// Oluşturulan FaceAnalysisResult'ı results dilimine ekler.
-		results = append(results, result)
+		// Her bir yüz için bir FaceAnalysisResult oluşturur.
+		result.Faces = append(result.Faces, &FaceAnalysisResult{
+			Emotion:             emotion,
+			Confidence:          confidence,
+			Scores:              scores,
+			DetectionConfidence: float64(faceAnnotation.DetectionConfidence),
+			Geometry:            geometry,
+		})
 	}
 
-	return results, nil
-}
+	// Etiketleri Vision API'nin döndürdüğü sırayla (puana göre azalan) ekler.
+	for _, labelAnnotation := range annotations.LabelAnnotations {
+		result.Labels = append(result.Labels, &LabelResult{
+			Description: labelAnnotation.Description,
+			Score:       float64(labelAnnotation.Score),
+			Mid:         labelAnnotation.Mid,
+		})
+	}
 
-func determineEmotion(faceAnnotation *visionpb.FaceAnnotation) string {
-	// Yüz analizi sonuçlarına göre duyguyu belirler.
-	// Örnek olarak, Joy, Sorrow, Anger, Surprise, vb. gibi duyguları belirlemek için bir algoritma kullanır.
-	// Kullanılacak algoritma, API'nin dönüş değerlerine göre günceller.
+	if opts.TextMode != TextDetectionMode_TEXT_DETECTION_NONE {
+		result.Text = extractText(annotations)
+	}
 
-	// Örnek bir algoritma: Yüzün gülümsüyüp gülümsmediğini kontrol eder.
-	if faceAnnotation.JoyLikelihood == visionpb.Likelihood_VERY_LIKELY || faceAnnotation.JoyLikelihood == visionpb.Likelihood_LIKELY {
-		// Yüz gülümsüyorsa, "Joy" yani sevinç döndürür.
-		return "Joy"
+	// Vision API olasılık değerleri Likelihood enum'uyla aynı sayısal karşılıklara sahiptir.
+	if safeSearch := annotations.GetSafeSearchAnnotation(); opts.SafeSearch && safeSearch != nil {
+		result.SafeSearch = &SafeSearch{
+			Adult:    Likelihood(safeSearch.Adult),
+			Violence: Likelihood(safeSearch.Violence),
+			Racy:     Likelihood(safeSearch.Racy),
+			Medical:  Likelihood(safeSearch.Medical),
+			Spoof:    Likelihood(safeSearch.Spoof),
+		}
 	}
 
-	// Örnek bir algoritma: Gözlerin kapanıp kapanmadığını kontrol eder.
-	if faceAnnotation.SorrowLikelihood == visionpb.Likelihood_VERY_LIKELY || faceAnnotation.SorrowLikelihood == visionpb.Likelihood_LIKELY {
-		// Gözler kapatılmışsa, "Sorrow" yani üzüntü döndürür.
-		return "Sorrow"
+	// Yer işaretleri puana göre azalan sırayla döner; koordinatı olan ilk sonuç kullanılır.
+	if opts.Landmarks {
+		for _, landmark := range annotations.LandmarkAnnotations {
+			if len(landmark.Locations) == 0 || landmark.Locations[0].GetLatLng() == nil {
+				continue
+			}
+			latLng := landmark.Locations[0].GetLatLng()
+			result.Landmark = &LandmarkResult{
+				Name:      landmark.Description,
+				Score:     float64(landmark.Score),
+				Latitude:  latLng.Latitude,
+				Longitude: latLng.Longitude,
+			}
+			break
+		}
 	}
 
-	// Örnek bir algoritma: Kaşların çatılıp çatılmadığını kontrol eder.
-	if faceAnnotation.AngerLikelihood == visionpb.Likelihood_VERY_LIKELY || faceAnnotation.AngerLikelihood == visionpb.Likelihood_LIKELY {
-		// Kaşlar çatılmışsa, "Anger" yani öfke döndürür.
-		return "Anger"
+	return result, nil
+}
+
+// extractText, OCR yanıtındaki tam metni ve blokları düzleştirir. Metin bulunamazsa nil döndürür.
+func extractText(annotations *visionpb.AnnotateImageResponse) *TextResult {
+	fullText := annotations.GetFullTextAnnotation()
+	if fullText == nil {
+		// Eski yanıtlar yalnızca TextAnnotations döndürür; ilk eleman tüm metni içerir.
+		if len(annotations.TextAnnotations) == 0 {
+			return nil
+		}
+		first := annotations.TextAnnotations[0]
+		return &TextResult{FullText: first.Description, Language: first.Locale}
+	}
+	if strings.TrimSpace(fullText.Text) == "" {
+		return nil
 	}
 
-	// Örnek bir algoritma: Şaşkın ifadeyi kontrol eder.
-	if faceAnnotation.SurpriseLikelihood == visionpb.Likelihood_VERY_LIKELY || faceAnnotation.SurpriseLikelihood == visionpb.Likelihood_LIKELY {
-		// Şaşkın ifade varsa, "Surprise" yani şaşkınlık döndürür.
-		return "Surprise"
+	result := &TextResult{FullText: fullText.Text}
+	for _, page := range fullText.Pages {
+		if result.Language == "" {
+			if languages := page.GetProperty().GetDetectedLanguages(); len(languages) > 0 {
+				result.Language = languages[0].LanguageCode
+			}
+		}
+		for _, block := range page.Blocks {
+			text := blockText(block)
+			if text == "" {
+				continue
+			}
+			textBlock := &TextBlockResult{
+				Text:       text,
+				Confidence: float64(block.Confidence),
+			}
+			for _, vertex := range block.GetBoundingBox().GetVertices() {
+				textBlock.BoundingBox = append(textBlock.BoundingBox, &Vertex{X: vertex.X, Y: vertex.Y})
+			}
+			result.Blocks = append(result.Blocks, textBlock)
+		}
 	}
-	return "Unknown" // Eğer belirlenebilmiş bir duygu yoksa "Unknown" yani bilinmeyen olarak döndür.
+	return result
 }
 
-// calculateConfidence, yüz analizi sonuçlarından güvenilirlik puanını hesaplar.
-func calculateConfidence(faceAnnotation *visionpb.FaceAnnotation) float64 {
-	// Burada yüz analizi sonuçlarından güvenilirlik puanını hesaplar.
-
-	// Eğer yüz analizi sonuçları yoksa veya güvenilirlik puanı belirlenmemişse, varsayılan bir değer döndürür.
-	if faceAnnotation.DetectionConfidence == 0 {
-		return 0.0
+// blockText, bir bloğun sembollerini Vision API'nin tespit ettiği boşluk ve satır sonlarıyla birleştirir.
+func blockText(block *visionpb.Block) string {
+	var sb strings.Builder
+	for _, paragraph := range block.Paragraphs {
+		for _, word := range paragraph.Words {
+			for _, symbol := range word.Symbols {
+				sb.WriteString(symbol.Text)
+				switch symbol.GetProperty().GetDetectedBreak().GetType() {
+				case visionpb.TextAnnotation_DetectedBreak_SPACE, visionpb.TextAnnotation_DetectedBreak_SURE_SPACE:
+					sb.WriteString(" ")
+				case visionpb.TextAnnotation_DetectedBreak_EOL_SURE_SPACE, visionpb.TextAnnotation_DetectedBreak_LINE_BREAK:
+					sb.WriteString("\n")
+				case visionpb.TextAnnotation_DetectedBreak_HYPHEN:
+					sb.WriteString("-\n")
+				}
+			}
+		}
 	}
+	return strings.TrimSpace(sb.String())
+}
 
-	// Vision API tarafından sağlanan güvenilirlik puanını döndürür.
-	return float64(faceAnnotation.DetectionConfidence)
+// faceScores, Vision API yüz açıklamasındaki tüm duygu ve özellik derecelerini alır.
+// Vision API olasılık değerleri Likelihood enum'uyla aynı sayısal karşılıklara sahiptir.
+func faceScores(faceAnnotation *visionpb.FaceAnnotation) FaceScores {
+	return FaceScores{
+		Joy:          Likelihood(faceAnnotation.JoyLikelihood),
+		Sorrow:       Likelihood(faceAnnotation.SorrowLikelihood),
+		Anger:        Likelihood(faceAnnotation.AngerLikelihood),
+		Surprise:     Likelihood(faceAnnotation.SurpriseLikelihood),
+		Blurred:      Likelihood(faceAnnotation.BlurredLikelihood),
+		Headwear:     Likelihood(faceAnnotation.HeadwearLikelihood),
+		UnderExposed: Likelihood(faceAnnotation.UnderExposedLikelihood),
+	}
 }
 
 // FaceAnalysisResult, yüz analizi sonuçlarını temsil eder.
 type FaceAnalysisResult struct {
-	Emotion    string  // Algılanan duygu (örneğin, Joy, Sorrow, Anger, Surprise vb.)
-	Confidence float64 // Duygu algısının güvenilirlik puanı
+	Emotion             string        // Baskın duygu (örneğin, Joy, Sorrow, Anger, Surprise) ya da "Unknown"
+	Confidence          float64       // Baskın duygunun olasılığı
+	Scores              FaceScores    // Tüm duygu ve özellik dereceleri
+	DetectionConfidence float64       // Yüzün bulunduğuna dair Vision API güveni
+	Geometry            *FaceGeometry // Görüntü boyutlarına göre normalize edilmiş konum ve duruş; boyut yoksa nil
+}
+
+// imageDimensions, yanıttaki OCR sayfa boyutlarını kullanır; OCR istenmediyse indirilmiş görüntünün
+// başlığını okur.
+func imageDimensions(content []byte, annotations *visionpb.AnnotateImageResponse) (int, int, error) {
+	if pages := annotations.GetFullTextAnnotation().GetPages(); len(pages) > 0 && pages[0].Width > 0 && pages[0].Height > 0 {
+		return int(pages[0].Width), int(pages[0].Height), nil
+	}
+	return imageSize(content)
+}
+
+// faceGeometry, Vision API'nin piksel cinsinden döndürdüğü yüz kutularını, işaret noktalarını ve açılarını
+// görüntü boyutlarına göre normalize eder.
+func faceGeometry(faceAnnotation *visionpb.FaceAnnotation, width, height int) *FaceGeometry {
+	normalize := func(x, y float32) (float32, float32) {
+		return clampUnit(x / float32(width)), clampUnit(y / float32(height))
+	}
+	poly := func(bp *visionpb.BoundingPoly) []*NormalizedVertex {
+		var vertices []*NormalizedVertex
+		for _, v := range bp.GetVertices() {
+			x, y := normalize(float32(v.X), float32(v.Y))
+			vertices = append(vertices, &NormalizedVertex{X: x, Y: y})
+		}
+		return vertices
+	}
+
+	geometry := &FaceGeometry{
+		BoundingPoly:   poly(faceAnnotation.BoundingPoly),
+		FdBoundingPoly: poly(faceAnnotation.FdBoundingPoly),
+		RollAngle:      faceAnnotation.RollAngle,
+		PanAngle:       faceAnnotation.PanAngle,
+		TiltAngle:      faceAnnotation.TiltAngle,
+	}
+	for _, landmark := range faceAnnotation.Landmarks {
+		x, y := normalize(landmark.GetPosition().GetX(), landmark.GetPosition().GetY())
+		geometry.Landmarks = append(geometry.Landmarks, &FaceLandmark{
+			Type: landmark.Type.String(),
+			X:    x,
+			Y:    y,
+			Z:    landmark.GetPosition().GetZ(),
+		})
+	}
+	return geometry
+}
+
+// clampUnit, değeri [0, 1] aralığına sınırlar. Vision API kısmen kadraj dışındaki yüzlerde görüntü dışına
+// taşan koordinatlar döndürebilir.
+func clampUnit(v float32) float32 {
+	if v < 0 {
+		return 0
+	}
+	if v > 1 {
+		return 1
+	}
+	return v
 }
 
 // Close, Vision API istemcisini kapatır.
 func (v *VisionAPI) Close() {
 	if v.client != nil {
 		if err := v.client.Close(); err != nil {
-			log.Printf("Vision API istemcisi kapatılamadı: %v", err)
+			slog.Error("Vision API istemcisi kapatılamadı", "error", err)
 		}
 	}
 }
@@ -0,0 +1,35 @@
+package photo
+
+import (
+	"testing"
+
+	"cloud.google.com/go/vision/v2/apiv1/visionpb"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestFaceGeometry(t *testing.T) {
+	annotation := &visionpb.FaceAnnotation{
+		BoundingPoly: &visionpb.BoundingPoly{Vertices: []*visionpb.Vertex{{X: 100, Y: 50}, {X: 300, Y: 50}, {X: 300, Y: 250}, {X: 100, Y: 250}}},
+		// Kısmen kadraj dışındaki yüz; koordinatlar [0, 1] aralığına sınırlanır.
+		FdBoundingPoly: &visionpb.BoundingPoly{Vertices: []*visionpb.Vertex{{X: -20, Y: 60}, {X: 420, Y: 240}}},
+		Landmarks: []*visionpb.FaceAnnotation_Landmark{
+			{Type: visionpb.FaceAnnotation_Landmark_NOSE_TIP, Position: &visionpb.Position{X: 200, Y: 150, Z: -12}},
+		},
+		RollAngle: 5,
+		PanAngle:  -10,
+		TiltAngle: 2,
+	}
+
+	got := faceGeometry(annotation, 400, 200)
+	want := &FaceGeometry{
+		BoundingPoly:   []*NormalizedVertex{{X: 0.25, Y: 0.25}, {X: 0.75, Y: 0.25}, {X: 0.75, Y: 1}, {X: 0.25, Y: 1}},
+		FdBoundingPoly: []*NormalizedVertex{{X: 0, Y: 0.3}, {X: 1, Y: 1}},
+		Landmarks:      []*FaceLandmark{{Type: "NOSE_TIP", X: 0.5, Y: 0.75, Z: -12}},
+		RollAngle:      5,
+		PanAngle:       -10,
+		TiltAngle:      2,
+	}
+	if !proto.Equal(got, want) {
+		t.Errorf("faceGeometry = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,190 @@
+package photo
+
+import (
+	"container/list"
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"myphotoapp/config"
+)
+
+const (
+	defaultVisionCacheSize = 1000           // Bellekte tutulan en fazla analiz sonucu
+	defaultVisionCacheTTL  = 24 * time.Hour // Bir analiz sonucunun geçerli kaldığı süre
+)
+
+// VisionCache, Vision API analiz sonuçlarını görüntü içeriğine ve istenen özelliklere göre saklar. Sonuçlar
+// bellekte LRU olarak tutulur; istenirse Postgres'te de saklanır ve kopyalar arasında paylaşılır.
+// Sonuçlar JSON olarak saklandığından her okuma bağımsız bir kopya döndürür.
+type VisionCache struct {
+	capacity int
+	ttl      time.Duration
+	persist  bool
+
+	mu    sync.Mutex
+	order *list.List // Öndeki en son kullanılandır
+	items map[string]*list.Element
+}
+
+type visionCacheEntry struct {
+	key     string
+	value   []byte
+	expires time.Time
+}
+
+// NewVisionCache, yapılandırmaya göre yeni bir VisionCache oluşturur. Önbellek kapalıysa nil döner.
+func NewVisionCache(cfg config.VisionCacheConfig) *VisionCache {
+	if !cfg.Enabled {
+		return nil
+	}
+	c := &VisionCache{
+		capacity: cfg.Size,
+		ttl:      cfg.TTL,
+		persist:  cfg.Postgres,
+		order:    list.New(),
+		items:    make(map[string]*list.Element),
+	}
+	if c.capacity <= 0 {
+		c.capacity = defaultVisionCacheSize
+	}
+	if c.ttl <= 0 {
+		c.ttl = defaultVisionCacheTTL
+	}
+	return c
+}
+
+// Get, anahtarın sonucunu önce bellekten, bulunamazsa Postgres'ten okur.
+func (c *VisionCache) Get(ctx context.Context, key string) (*ImageAnalysisResult, bool) {
+	value, ok := c.getMemory(key)
+	if !ok && c.persist {
+		var err error
+		value, ok, err = c.getStored(ctx, key)
+		if err != nil {
+			slog.ErrorContext(ctx, "Vision önbelleği okunamadı", "error", err)
+		}
+		if ok {
+			c.putMemory(key, value)
+		}
+	}
+	if !ok {
+		return nil, false
+	}
+
+	var result ImageAnalysisResult
+	if err := json.Unmarshal(value, &result); err != nil {
+		slog.ErrorContext(ctx, "Vision önbelleğindeki sonuç çözümlenemedi", "error", err)
+		return nil, false
+	}
+	return &result, true
+}
+
+// Put, anahtarın sonucunu bellekte ve etkinse Postgres'te saklar.
+func (c *VisionCache) Put(ctx context.Context, key string, result *ImageAnalysisResult) {
+	value, err := json.Marshal(result)
+	if err != nil {
+		slog.ErrorContext(ctx, "Vision sonucu önbelleğe yazılamadı", "error", err)
+		return
+	}
+	c.putMemory(key, value)
+	if c.persist {
+		if err := c.putStored(ctx, key, value); err != nil {
+			slog.ErrorContext(ctx, "Vision sonucu önbelleğe yazılamadı", "error", err)
+		}
+	}
+}
+
+func (c *VisionCache) getMemory(key string) ([]byte, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	elem, ok := c.items[key]
+	if !ok {
+		return nil, false
+	}
+	entry := elem.Value.(*visionCacheEntry)
+	if now().After(entry.expires) {
+		c.order.Remove(elem)
+		delete(c.items, key)
+		return nil, false
+	}
+	c.order.MoveToFront(elem)
+	return entry.value, true
+}
+
+func (c *VisionCache) putMemory(key string, value []byte) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	entry := &visionCacheEntry{key: key, value: value, expires: now().Add(c.ttl)}
+	if elem, ok := c.items[key]; ok {
+		elem.Value = entry
+		c.order.MoveToFront(elem)
+		return
+	}
+	c.items[key] = c.order.PushFront(entry)
+	for c.order.Len() > c.capacity {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.items, oldest.Value.(*visionCacheEntry).key)
+	}
+}
+
+func (c *VisionCache) getStored(ctx context.Context, key string) ([]byte, bool, error) {
+	var value []byte
+	err := db.QueryRowContext(ctx, `SELECT result FROM vision_cache WHERE cache_key = $1 AND created_at > $2`,
+		key, now().Add(-c.ttl).UTC()).Scan(&value)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, err
+	}
+	return value, true, nil
+}
+
+func (c *VisionCache) putStored(ctx context.Context, key string, value []byte) error {
+	_, err := db.ExecContext(ctx, `INSERT INTO vision_cache (cache_key, result, created_at) VALUES ($1, $2, $3)
+        ON CONFLICT (cache_key) DO UPDATE SET result = EXCLUDED.result, created_at = EXCLUDED.created_at`,
+		key, value, now().UTC())
+	return err
+}
+
+// PruneVisionCache, Postgres'teki süresi dolmuş analiz sonuçlarını siler.
+func PruneVisionCache(ctx context.Context, ttl time.Duration) (int64, error) {
+	res, err := db.ExecContext(ctx, `DELETE FROM vision_cache WHERE created_at < $1`, now().Add(-ttl).UTC())
+	if err != nil {
+		return 0, err
+	}
+	return res.RowsAffected()
+}
+
+// visionCacheKey, görüntünün parmak izi ile istenen özelliklerden önbellek anahtarını üretir.
+func visionCacheKey(fingerprint string, opts AnalyzeOptions) string {
+	maxLabels := opts.MaxLabels
+	if maxLabels <= 0 {
+		maxLabels = defaultMaxLabels
+	}
+	features := fmt.Sprintf("labels=%t/%d text=%d safe=%t landmarks=%t",
+		opts.DetectLabels, maxLabels, opts.TextMode, opts.SafeSearch, opts.Landmarks)
+	sum := sha256.Sum256([]byte(fingerprint + "\x00" + features))
+	return hex.EncodeToString(sum[:])
+}
+
+// etagFingerprint, sunucunun ETag verdiği görüntüyü URL ve ETag ile tanımlar. Görüntü indirilmeden üretildiği
+// için önbellekteki sonuç indirme yapılmadan döndürülebilir.
+func etagFingerprint(imageURI, etag string) string {
+	return "etag:" + imageURI + "\x00" + etag
+}
+
+// contentFingerprint, indirilmiş görüntüyü içeriğinin SHA-256 özetiyle tanımlar. İçerik özetiyle aynı
+// görüntünün farklı URL'lerden yüklenmesi de önbellekten karşılanır.
+func contentFingerprint(content []byte) string {
+	sum := sha256.Sum256(content)
+	return "sha256:" + hex.EncodeToString(sum[:])
+}
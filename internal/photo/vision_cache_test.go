@@ -0,0 +1,101 @@
+package photo
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"myphotoapp/config"
+)
+
+func TestVisionCacheLRUAndTTL(t *testing.T) {
+	start := time.Unix(1700000000, 0)
+	defer func(orig func() time.Time) { now = orig }(now)
+	now = func() time.Time { return start }
+
+	c := NewVisionCache(config.VisionCacheConfig{Enabled: true, Size: 2, TTL: time.Hour})
+	ctx := context.Background()
+	c.Put(ctx, "a", &ImageAnalysisResult{Width: 1})
+	c.Put(ctx, "b", &ImageAnalysisResult{Width: 2})
+	c.Get(ctx, "a") // a en son kullanılan olur
+	c.Put(ctx, "c", &ImageAnalysisResult{Width: 3})
+
+	if _, ok := c.Get(ctx, "b"); ok {
+		t.Error("en uzun süredir kullanılmayan sonuç çıkarılmadı")
+	}
+	got, ok := c.Get(ctx, "a")
+	if !ok || got.Width != 1 {
+		t.Fatalf("Get(a) = %v, %v", got, ok)
+	}
+	// Her okuma bağımsız bir kopya döndürür.
+	got.Width = 99
+	if again, _ := c.Get(ctx, "a"); again.Width != 1 {
+		t.Error("önbellekteki sonuç okuyan tarafından değiştirildi")
+	}
+
+	now = func() time.Time { return start.Add(2 * time.Hour) }
+	if _, ok := c.Get(ctx, "a"); ok {
+		t.Error("süresi dolan sonuç döndürüldü")
+	}
+
+	if NewVisionCache(config.VisionCacheConfig{}) != nil {
+		t.Error("kapalı önbellek nil olmalı")
+	}
+}
+
+func TestAnalyzeImageCacheHitWithoutDownload(t *testing.T) {
+	var gets atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/etag.png" {
+			w.Header().Set("ETag", `"v1"`)
+		}
+		w.Header().Set("Content-Type", "image/png")
+		if r.Method == http.MethodGet {
+			gets.Add(1)
+			w.Write(testPNG)
+		}
+	}))
+	defer srv.Close()
+
+	ctx := context.Background()
+	opts := AnalyzeOptions{DetectLabels: true}
+	// Vision API istemcisi olmadığından önbellekte bulunmayan bir sonuç testi başarısız kılar.
+	v := &VisionAPI{
+		fetcher: newTestFetcher(t, config.FetchConfig{AllowedCIDRs: loopbackCIDRs}),
+		cache:   NewVisionCache(config.VisionCacheConfig{Enabled: true}),
+	}
+	v.cache.Put(ctx, visionCacheKey(etagFingerprint(srv.URL+"/etag.png", `"v1"`), opts), &ImageAnalysisResult{Width: 1})
+	v.cache.Put(ctx, visionCacheKey(contentFingerprint(testPNG), opts), &ImageAnalysisResult{Width: 2})
+
+	result, err := v.AnalyzeImage(ctx, srv.URL+"/etag.png", opts)
+	if err != nil || result.Width != 1 {
+		t.Fatalf("AnalyzeImage(ETag) = %v, %v, want önbellekteki sonuç", result, err)
+	}
+	if n := gets.Load(); n != 0 {
+		t.Errorf("ETag veren sunucudan %d kez görüntü indirildi, want 0", n)
+	}
+
+	// ETag yoksa içerik özeti kullanılır; aynı içerik başka bir URL'den de önbellekten karşılanır.
+	result, err = v.AnalyzeImage(ctx, srv.URL+"/other.png", opts)
+	if err != nil || result.Width != 2 {
+		t.Fatalf("AnalyzeImage(içerik) = %v, %v, want önbellekteki sonuç", result, err)
+	}
+	if n := gets.Load(); n != 1 {
+		t.Errorf("görüntü %d kez indirildi, want 1", n)
+	}
+}
+
+func TestVisionCacheKeyDependsOnFeatures(t *testing.T) {
+	fingerprint := contentFingerprint(testPNG)
+	if visionCacheKey(fingerprint, AnalyzeOptions{}) == visionCacheKey(fingerprint, AnalyzeOptions{DetectLabels: true}) {
+		t.Error("farklı özellikler için anahtar aynı olmamalı")
+	}
+	// Varsayılan etiket sayısı açıkça istenmişle aynı sonucu verir.
+	if visionCacheKey(fingerprint, AnalyzeOptions{DetectLabels: true}) !=
+		visionCacheKey(fingerprint, AnalyzeOptions{DetectLabels: true, MaxLabels: defaultMaxLabels}) {
+		t.Error("varsayılan etiket sayısı için anahtar değişmemeli")
+	}
+}
@@ -0,0 +1,110 @@
+package photo
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"log/slog"
+	"time"
+
+	"myphotoapp/config"
+
+	"google.golang.org/grpc/status"
+)
+
+// visionLimits, Vision API çağrılarına uygulanan genel eşzamanlılık sınırı ve kiracı bütçeleridir.
+type visionLimits struct {
+	slots  chan struct{} // nil ise eşzamanlılık sınırsızdır
+	budget config.VisionBudgetConfig
+}
+
+func newVisionLimits(cfg config.VisionConfig) *visionLimits {
+	l := &visionLimits{budget: cfg.Budget}
+	if cfg.MaxConcurrent > 0 {
+		l.slots = make(chan struct{}, cfg.MaxConcurrent)
+	}
+	return l
+}
+
+// dailyBudget, kiracının günlük birim bütçesini döndürür. 0 sınırsız demektir.
+func (l *visionLimits) dailyBudget(tenant string) int {
+	if budget, ok := l.budget.Tenants[tenant]; ok {
+		return budget
+	}
+	return l.budget.DailyUnits
+}
+
+// acquire, çağrının birimlerini kiracının bütçesinden düşer ve bir çağrı yuvası bekler. Dönen fonksiyon
+// çağrı bitince yuvayı bırakır; çağrı başarısız olduysa düşülen birimler iade edilir. Sunucu içi çağrılar
+// (kiracısı olmayanlar) bütçeye tabi değildir.
+func (l *visionLimits) acquire(ctx context.Context, units int) (func(succeeded bool), error) {
+	tenant := tenantIdentity(ctx)
+	day := now().UTC().Truncate(24 * time.Hour)
+
+	charged := false
+	if budget := l.dailyBudget(tenant); tenant != "" && budget > 0 {
+		ok, err := chargeVisionUnits(ctx, tenant, day, units, budget)
+		switch {
+		case err != nil:
+			// Bütçe kaydı tutulamıyorsa yüklemeler engellenmez.
+			slog.WarnContext(ctx, "Vision bütçesi güncellenemedi, çağrıya izin veriliyor", "error", err)
+		case !ok:
+			return nil, resourceExhausted(ctx, day.Add(24*time.Hour).Sub(now()),
+				"günlük Vision bütçesi (%d birim) doldu", budget)
+		default:
+			charged = true
+		}
+	}
+
+	refund := func() {
+		if !charged {
+			return
+		}
+		if err := refundVisionUnits(tenant, day, units); err != nil {
+			slog.ErrorContext(ctx, "Vision bütçesi iade edilemedi", "error", err)
+		}
+	}
+
+	if l.slots != nil {
+		select {
+		case l.slots <- struct{}{}:
+		case <-ctx.Done():
+			refund()
+			return nil, status.FromContextError(ctx.Err()).Err()
+		}
+	}
+
+	return func(succeeded bool) {
+		if l.slots != nil {
+			<-l.slots
+		}
+		if !succeeded {
+			refund()
+		}
+	}, nil
+}
+
+// chargeVisionUnits, bütçe aşılmıyorsa birimleri kiracının günlük kullanımına ekler ve true döndürür.
+func chargeVisionUnits(ctx context.Context, tenant string, day time.Time, units, budget int) (bool, error) {
+	if units > budget {
+		return false, nil
+	}
+	var used int
+	err := db.QueryRowContext(ctx, `
+		INSERT INTO vision_usage (tenant_id, day, units) VALUES ($1, $2, $3)
+		ON CONFLICT (tenant_id, day) DO UPDATE SET units = vision_usage.units + EXCLUDED.units
+		WHERE vision_usage.units + EXCLUDED.units <= $4
+		RETURNING units`, tenant, day, units, budget).Scan(&used)
+	if errors.Is(err, sql.ErrNoRows) {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+// refundVisionUnits, başarısız bir çağrı için düşülen birimleri iade eder. İstek iptal edilmiş olabileceğinden
+// isteğin bağlamı kullanılmaz.
+func refundVisionUnits(tenant string, day time.Time, units int) error {
+	_, err := db.Exec(`UPDATE vision_usage SET units = GREATEST(units - $3, 0) WHERE tenant_id = $1 AND day = $2`,
+		tenant, day, units)
+	return err
+}
@@ -0,0 +1,236 @@
+package photo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"myphotoapp/config"
+
+	"cloud.google.com/go/vision/v2/apiv1/visionpb"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	defaultVisionCallTimeout       = 15 * time.Second
+	defaultVisionMaxAttempts       = 3
+	defaultVisionInitialBackoff    = 200 * time.Millisecond
+	defaultVisionMaxBackoff        = 2 * time.Second
+	defaultBreakerFailureThreshold = 5
+	defaultBreakerCooldown         = 30 * time.Second
+)
+
+// errVisionCircuitOpen, devre kesici açıkken Vision API çağrısı yapılmadığında döner.
+var errVisionCircuitOpen = status.Error(codes.Unavailable, "Vision API geçici olarak kullanılamıyor (devre kesici açık)")
+
+// isTransientVisionError, hatanın Vision API'nin geçici olarak kullanılamamasından kaynaklandığını bildirir.
+// Bu hatalar yeniden denenir ve devre kesicide başarısızlık sayılır.
+func isTransientVisionError(err error) bool {
+	switch status.Code(err) {
+	case codes.Unavailable, codes.DeadlineExceeded, codes.Aborted, codes.Internal:
+		return true
+	}
+	return false
+}
+
+// isVisionUnavailable, yeniden denemelerden sonra da Vision API'ye ulaşılamadığını bildirir. Bu durumda
+// yüklemeler reddedilmek yerine analiz kuyruğuna alınır.
+func isVisionUnavailable(err error) bool {
+	return errors.Is(err, errVisionCircuitOpen) || isTransientVisionError(err)
+}
+
+// Devre kesici durumları.
+const (
+	breakerClosed   = "closed"
+	breakerOpen     = "open"
+	breakerHalfOpen = "half_open"
+)
+
+// BreakerSnapshot, devre kesicinin anlık durumudur.
+type BreakerSnapshot struct {
+	State               string    `json:"state"`
+	ConsecutiveFailures int       `json:"consecutive_failures"`
+	OpenedAt            time.Time `json:"opened_at,omitempty"`
+	LastError           string    `json:"last_error,omitempty"`
+}
+
+// CircuitBreaker, art arda başarısız olan Vision API çağrılarından sonra çağrıları bir süre durdurur. Süre
+// dolunca tek bir deneme çağrısına izin verilir; başarılı olursa devre kapanır, olmazsa yeniden açılır.
+type CircuitBreaker struct {
+	threshold int
+	cooldown  time.Duration
+
+	mu       sync.Mutex
+	state    string
+	failures int
+	openedAt time.Time
+	lastErr  string
+	probing  bool
+}
+
+func newCircuitBreaker(cfg config.VisionBreakerConfig) *CircuitBreaker {
+	b := &CircuitBreaker{threshold: cfg.FailureThreshold, cooldown: cfg.Cooldown, state: breakerClosed}
+	if b.threshold <= 0 {
+		b.threshold = defaultBreakerFailureThreshold
+	}
+	if b.cooldown <= 0 {
+		b.cooldown = defaultBreakerCooldown
+	}
+	return b
+}
+
+// allow, bir çağrının yapılıp yapılamayacağını bildirir. Yarı açık durumda aynı anda tek çağrıya izin verilir.
+func (b *CircuitBreaker) allow() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	switch b.state {
+	case breakerOpen:
+		if now().Sub(b.openedAt) < b.cooldown {
+			return false
+		}
+		b.state = breakerHalfOpen
+		fallthrough
+	case breakerHalfOpen:
+		if b.probing {
+			return false
+		}
+		b.probing = true
+	}
+	return true
+}
+
+// record, izin verilen bir çağrının sonucunu kaydeder.
+func (b *CircuitBreaker) record(err error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.probing = false
+	if err == nil {
+		b.state, b.failures, b.lastErr = breakerClosed, 0, ""
+		return
+	}
+
+	b.failures++
+	b.lastErr = err.Error()
+	if b.state == breakerHalfOpen || b.failures >= b.threshold {
+		b.state = breakerOpen
+		b.openedAt = now()
+	}
+}
+
+// abandon, sonucu bilinmeyen bir çağrıyı (örneğin, istemci isteği iptal ettiğinde) sayılmadan bırakır.
+func (b *CircuitBreaker) abandon() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.probing = false
+}
+
+// Snapshot, devre kesicinin anlık durumunu döndürür.
+func (b *CircuitBreaker) Snapshot() BreakerSnapshot {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	snapshot := BreakerSnapshot{State: b.state, ConsecutiveFailures: b.failures, LastError: b.lastErr}
+	if b.state != breakerClosed {
+		snapshot.OpenedAt = b.openedAt
+	}
+	return snapshot
+}
+
+// visionRetryPolicy, geçici Vision API hatalarının nasıl yeniden deneneceğini belirler.
+type visionRetryPolicy struct {
+	callTimeout    time.Duration
+	maxAttempts    int
+	initialBackoff time.Duration
+	maxBackoff     time.Duration
+}
+
+func newVisionRetryPolicy(cfg config.VisionConfig) visionRetryPolicy {
+	p := visionRetryPolicy{
+		callTimeout:    cfg.Timeout,
+		maxAttempts:    cfg.Retry.MaxAttempts,
+		initialBackoff: cfg.Retry.InitialBackoff,
+		maxBackoff:     cfg.Retry.MaxBackoff,
+	}
+	if p.callTimeout <= 0 {
+		p.callTimeout = defaultVisionCallTimeout
+	}
+	if p.maxAttempts <= 0 {
+		p.maxAttempts = defaultVisionMaxAttempts
+	}
+	if p.initialBackoff <= 0 {
+		p.initialBackoff = defaultVisionInitialBackoff
+	}
+	if p.maxBackoff <= 0 {
+		p.maxBackoff = defaultVisionMaxBackoff
+	}
+	return p
+}
+
+// annotate, AnnotateImage çağrısını çağrı başına süre sınırıyla yapar; geçici hatalarda üstel artan
+// beklemelerle yeniden dener. Devre kesici açıksa çağrı yapılmaz.
+func (v *VisionAPI) annotate(ctx context.Context, req *visionpb.AnnotateImageRequest) (resp *visionpb.AnnotateImageResponse, err error) {
+	ctx, span := tracer.Start(ctx, "vision.AnnotateImage", trace.WithSpanKind(trace.SpanKindClient))
+	defer func() { endSpan(span, err) }()
+
+	backoff := v.retry.initialBackoff
+	for attempt := 1; ; attempt++ {
+		if !v.breaker.allow() {
+			return nil, errVisionCircuitOpen
+		}
+		span.SetAttributes(attribute.Int("vision.attempts", attempt))
+
+		callCtx, cancel := context.WithTimeout(ctx, v.retry.callTimeout)
+		start := time.Now()
+		resp, err := v.client.AnnotateImage(callCtx, req)
+		cancel()
+		code := status.Code(err).String()
+		visionDuration.WithLabelValues(code).Observe(time.Since(start).Seconds())
+		if err != nil {
+			visionErrors.WithLabelValues(code).Inc()
+		}
+
+		// İstek iptal edildiyse hata Vision API'ye ait değildir.
+		if ctx.Err() != nil {
+			v.breaker.abandon()
+			return nil, status.FromContextError(ctx.Err()).Err()
+		}
+		if err == nil || !isTransientVisionError(err) {
+			// Kalıcı hatalar (örneğin, geçersiz URL) Vision API'nin çalıştığını gösterir.
+			v.breaker.record(nil)
+			return resp, err
+		}
+		v.breaker.record(err)
+		if attempt >= v.retry.maxAttempts {
+			return nil, err
+		}
+		slog.WarnContext(ctx, "Vision API çağrısı başarısız, yeniden deneniyor", "attempt", attempt, "backoff", backoff, "error", err)
+
+		select {
+		case <-ctx.Done():
+			return nil, status.FromContextError(ctx.Err()).Err()
+		case <-time.After(backoff):
+		}
+		backoff *= 2
+		if backoff > v.retry.maxBackoff {
+			backoff = v.retry.maxBackoff
+		}
+	}
+}
+
+// CheckAvailable, devre kesici açıksa Vision API'nin kullanılamadığını bildiren bir hata döndürür.
+func (v *VisionAPI) CheckAvailable() error {
+	if snapshot := v.breaker.Snapshot(); snapshot.State == breakerOpen {
+		return fmt.Errorf("devre kesici açık: %s", snapshot.LastError)
+	}
+	return nil
+}
+
+// Breaker, Vision API devre kesicisinin anlık durumunu döndürür.
+func (v *VisionAPI) Breaker() BreakerSnapshot {
+	return v.breaker.Snapshot()
+}
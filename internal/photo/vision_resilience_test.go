@@ -0,0 +1,87 @@
+package photo
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"myphotoapp/config"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestCircuitBreaker(t *testing.T) {
+	start := time.Unix(1700000000, 0)
+	defer func(orig func() time.Time) { now = orig }(now)
+	now = func() time.Time { return start }
+
+	b := newCircuitBreaker(config.VisionBreakerConfig{FailureThreshold: 2, Cooldown: time.Minute})
+	failure := status.Error(codes.Unavailable, "bağlantı yok")
+	for i := 0; i < 2; i++ {
+		if !b.allow() {
+			t.Fatalf("%d. çağrıya izin verilmedi", i+1)
+		}
+		b.record(failure)
+	}
+	if b.allow() {
+		t.Fatal("eşik aşıldıktan sonra çağrıya izin verildi")
+	}
+	if s := b.Snapshot(); s.State != breakerOpen || s.ConsecutiveFailures != 2 || !s.OpenedAt.Equal(start) {
+		t.Errorf("durum = %+v, want açık", s)
+	}
+
+	// Bekleme süresi dolunca aynı anda yalnızca bir deneme çağrısı yapılır.
+	now = func() time.Time { return start.Add(time.Minute) }
+	if !b.allow() {
+		t.Fatal("bekleme süresinden sonra deneme çağrısına izin verilmedi")
+	}
+	if b.allow() {
+		t.Error("yarı açık devrede ikinci çağrıya izin verildi")
+	}
+	b.record(failure)
+	if s := b.Snapshot(); s.State != breakerOpen {
+		t.Errorf("başarısız deneme sonrası durum = %s, want açık", s.State)
+	}
+
+	now = func() time.Time { return start.Add(2 * time.Minute) }
+	if !b.allow() {
+		t.Fatal("deneme çağrısına izin verilmedi")
+	}
+	b.record(nil)
+	if s := b.Snapshot(); s.State != breakerClosed || s.ConsecutiveFailures != 0 || s.LastError != "" {
+		t.Errorf("başarılı deneme sonrası durum = %+v, want kapalı", s)
+	}
+}
+
+func TestIsVisionUnavailable(t *testing.T) {
+	tests := []struct {
+		err  error
+		want bool
+	}{
+		{errVisionCircuitOpen, true},
+		{status.Error(codes.Unavailable, ""), true},
+		{status.Error(codes.DeadlineExceeded, ""), true},
+		{status.Error(codes.InvalidArgument, "geçersiz URL"), false},
+		{status.Error(codes.ResourceExhausted, "bütçe"), false},
+		{errors.New("bilinmeyen"), false},
+		{nil, false},
+	}
+	for _, tt := range tests {
+		if got := isVisionUnavailable(tt.err); got != tt.want {
+			t.Errorf("isVisionUnavailable(%v) = %v, want %v", tt.err, got, tt.want)
+		}
+	}
+}
+
+func TestAnalysisStatusDB(t *testing.T) {
+	for _, s := range []AnalysisStatus{AnalysisStatus_ANALYSIS_COMPLETE, AnalysisStatus_ANALYSIS_PENDING, AnalysisStatus_ANALYSIS_FAILED} {
+		if got := analysisStatusFromDB(analysisStatusToDB(s)); got != s {
+			t.Errorf("%v veritabanından %v olarak döndü", s, got)
+		}
+	}
+	// Sütun eklenmeden önceki kayıtların analizi tamamlanmıştır.
+	if got := analysisStatusFromDB(""); got != AnalysisStatus_ANALYSIS_COMPLETE {
+		t.Errorf("boş durum = %v, want COMPLETE", got)
+	}
+}
@@ -0,0 +1,561 @@
+package photo
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"log/slog"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"myphotoapp/config"
+
+	"github.com/jackc/pgx/v4/stdlib"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// feedEventsChannel, feed_events tablosuna kayıt eklendiğinde bildirim gönderilen LISTEN/NOTIFY kanalıdır.
+const feedEventsChannel = "feed_events"
+
+const (
+	defaultWatchBufferSize   = 256             // Abone başına bekletilebilecek en fazla olay
+	defaultWatchPollInterval = time.Second     // Bildirim gelmese de değişikliklerin yoklanma aralığı
+	defaultWatchRetention    = 24 * time.Hour  // Devam belirteçlerinin geçerli kaldığı süre
+	feedChangeBatchSize      = 500             // Tek seferde okunan en fazla değişiklik
+	listenRetryDelay         = 5 * time.Second // LISTEN bağlantısı koptuğunda yeniden deneme aralığı
+)
+
+// feedPosition, feed_events kaydındaki bir olayın konumudur. Konumlar (Tx, Seq) sırasıyla karşılaştırılır.
+// Yalnızca tamamlanmış işlemlerin kayıtları okunduğundan bir konumdan önceki tüm kayıtlar kesinleşmiştir.
+type feedPosition struct {
+	Tx  int64 `json:"t"`
+	Seq int64 `json:"s"`
+}
+
+func (p feedPosition) after(q feedPosition) bool {
+	return p.Tx > q.Tx || (p.Tx == q.Tx && p.Seq > q.Seq)
+}
+
+// encodeResumeToken, bir konumu istemciye verilecek opak devam belirtecine dönüştürür.
+func encodeResumeToken(p feedPosition) string {
+	data, _ := json.Marshal(p)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// decodeResumeToken, istemciden gelen devam belirtecini çözer.
+func decodeResumeToken(token string) (feedPosition, error) {
+	var p feedPosition
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return p, status.Error(codes.InvalidArgument, "geçersiz devam belirteci")
+	}
+	if err := json.Unmarshal(data, &p); err != nil || p.Tx <= 0 || p.Seq <= 0 {
+		return p, status.Error(codes.InvalidArgument, "geçersiz devam belirteci")
+	}
+	return p, nil
+}
+
+// feedChange, feed_events tablosundaki bir kayıttır.
+type feedChange struct {
+	pos        feedPosition
+	photoID    int64
+	op         string // INSERT, UPDATE veya DELETE
+	wasVisible bool   // Değişiklikten önce fotoğraf akışta görünüyordu
+	occurredAt time.Time
+}
+
+// completedTxSQL, henüz tamamlanmamış en eski işlemin kimliğidir; bundan küçük kimlikli kayıtlar kesinleşmiştir.
+const completedTxSQL = `txid_snapshot_xmin(txid_current_snapshot())`
+
+// readFeedChanges, after konumundan sonraki ve upTo konumunu (verilmişse) aşmayan kesinleşmiş değişiklikleri okur.
+func readFeedChanges(ctx context.Context, after feedPosition, upTo *feedPosition, limit int) ([]feedChange, error) {
+	args := []any{after.Tx, after.Seq, limit}
+	query := `SELECT tx, seq, photo_id, op, was_visible, occurred_at FROM feed_events
+        WHERE (tx, seq) > ($1, $2) AND tx < ` + completedTxSQL
+	if upTo != nil {
+		query += ` AND (tx, seq) <= ($4, $5)`
+		args = append(args, upTo.Tx, upTo.Seq)
+	}
+	query += ` ORDER BY tx, seq LIMIT $3`
+
+	rows, err := db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var changes []feedChange
+	for rows.Next() {
+		var c feedChange
+		if err := rows.Scan(&c.pos.Tx, &c.pos.Seq, &c.photoID, &c.op, &c.wasVisible, &c.occurredAt); err != nil {
+			return nil, err
+		}
+		changes = append(changes, c)
+	}
+	return changes, rows.Err()
+}
+
+// latestFeedPosition, kesinleşmiş son değişikliğin konumunu döndürür. Kayıt yoksa sıfır konum döner.
+func latestFeedPosition(ctx context.Context) (feedPosition, error) {
+	var p feedPosition
+	err := db.QueryRowContext(ctx, `SELECT COALESCE(max(tx), 0), COALESCE(max(seq), 0) FROM (
+            SELECT tx, seq FROM feed_events WHERE tx < `+completedTxSQL+`
+            ORDER BY tx DESC, seq DESC LIMIT 1) last`).Scan(&p.Tx, &p.Seq)
+	return p, err
+}
+
+// feedPositionRetained, konumdaki kaydın hâlâ saklandığını doğrular. Kayıt silinmişse aradaki değişiklikler
+// kaybolmuş olabilir.
+func feedPositionRetained(ctx context.Context, p feedPosition) (bool, error) {
+	var ok bool
+	err := db.QueryRowContext(ctx, `SELECT EXISTS (SELECT 1 FROM feed_events WHERE tx = $1 AND seq = $2)`,
+		p.Tx, p.Seq).Scan(&ok)
+	return ok, err
+}
+
+// PruneFeedEvents, saklama süresini aşmış değişiklik kayıtlarını siler.
+func PruneFeedEvents(ctx context.Context, retention time.Duration) (int64, error) {
+	res, err := db.ExecContext(ctx, `DELETE FROM feed_events WHERE occurred_at < $1`, now().Add(-retention).UTC())
+	if err != nil {
+		return 0, err
+	}
+	return res.RowsAffected()
+}
+
+// feedChangeSet, bir grup değişikliği ve değişen fotoğrafların güncel hallerini tutar. Aynı değişiklikler
+// farklı filtrelere göre olaylara dönüştürülebilir.
+type feedChangeSet struct {
+	changes []feedChange
+	photos  map[string]*UploadedImage // Silinmiş fotoğraflar yer almaz
+	ids     []int64
+}
+
+// loadFeedChangeSet, değişen fotoğrafların güncel hallerini ayrıntılarıyla yükler.
+func loadFeedChangeSet(ctx context.Context, changes []feedChange) (*feedChangeSet, error) {
+	set := &feedChangeSet{changes: changes, photos: make(map[string]*UploadedImage)}
+	seen := make(map[int64]bool)
+	for _, c := range changes {
+		if !seen[c.photoID] {
+			seen[c.photoID] = true
+			set.ids = append(set.ids, c.photoID)
+		}
+	}
+
+	rows, err := db.QueryContext(ctx, `SELECT `+photoColumns+` FROM photos p WHERE p.id = ANY($1)`, set.ids)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var images []*UploadedImage
+	for rows.Next() {
+		img, err := scanPhoto(rows)
+		if err != nil {
+			return nil, err
+		}
+		images = append(images, img)
+		set.photos[img.Id] = img
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	if err := loadImageDetails(ctx, images); err != nil {
+		return nil, err
+	}
+	return set, nil
+}
+
+// matching, değişen fotoğraflardan şu anda akışta görünen ve filtreye uyanları döndürür.
+func (s *feedChangeSet) matching(ctx context.Context, filter feedFilter) (map[string]bool, error) {
+	var args []any
+	arg := func(v any) string {
+		args = append(args, v)
+		return "$" + strconv.Itoa(len(args))
+	}
+	conds := append([]string{"p.id = ANY(" + arg(s.ids) + ")", "p.moderation_status = 'ACCEPTED'"},
+		filter.conditions(arg)...)
+
+	rows, err := db.QueryContext(ctx, `SELECT p.id FROM photos p WHERE `+strings.Join(conds, " AND "), args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	matched := make(map[string]bool)
+	for rows.Next() {
+		var id int64
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		matched[strconv.FormatInt(id, 10)] = true
+	}
+	return matched, rows.Err()
+}
+
+// events, değişiklikleri filtreye uyan fotoğraflar için akış olaylarına dönüştürür. Filtreye uymayan bir
+// güncelleme, fotoğraf önceden akışta görünüyorsa kaldırma olayı olur.
+func (s *feedChangeSet) events(matched map[string]bool) []*FeedEvent {
+	var events []*FeedEvent
+	for _, c := range s.changes {
+		event := &FeedEvent{
+			ImageId:     strconv.FormatInt(c.photoID, 10),
+			OccurredAt:  c.occurredAt.Unix(),
+			ResumeToken: encodeResumeToken(c.pos),
+		}
+		img, exists := s.photos[event.ImageId]
+		switch {
+		case exists && matched[event.ImageId] && c.op == "INSERT":
+			event.Type, event.Image = FeedEventType_FEED_EVENT_CREATED, img
+		case exists && matched[event.ImageId]:
+			event.Type, event.Image = FeedEventType_FEED_EVENT_UPDATED, img
+		case c.wasVisible:
+			event.Type = FeedEventType_FEED_EVENT_REMOVED
+		default:
+			continue
+		}
+		events = append(events, event)
+	}
+	return events
+}
+
+// feedSubscriber, bir WatchFeed akışının canlı olay kuyruğudur.
+type feedSubscriber struct {
+	filter  feedFilter
+	digest  string
+	start   feedPosition // Abonelik anındaki konum; canlı olaylar bundan sonrasıdır
+	events  chan *FeedEvent
+	evicted chan struct{} // Kuyruk dolduğunda kapatılır
+}
+
+// FeedBroadcaster, feed_events tablosundaki değişiklikleri okur ve süreç içindeki WatchFeed abonelerine dağıtır.
+// Değişiklikler veritabanı tetikleyicisiyle yazıldığından tüm kopyalardaki yazmalar her kopyaya ulaşır;
+// LISTEN/NOTIFY yalnızca okumayı hızlandırır, bildirim kaçsa da düzenli yoklama devam eder.
+type FeedBroadcaster struct {
+	bufferSize   int
+	pollInterval time.Duration
+	wake         chan struct{}
+	ready        chan struct{} // İmleç veritabanındaki son konuma ayarlandığında kapatılır
+	done         chan struct{} // Run döndüğünde kapatılır; açık akışlar bununla sonlanır
+	dispatchMu   sync.Mutex    // Dağıtım ile yeni abonelikleri sıralar
+
+	mu     sync.Mutex
+	cursor feedPosition
+	subs   map[*feedSubscriber]struct{}
+}
+
+// NewFeedBroadcaster, yapılandırmaya göre yeni bir FeedBroadcaster oluşturur. Olaylar Run çağrılınca dağıtılır.
+func NewFeedBroadcaster(cfg config.WatchConfig) *FeedBroadcaster {
+	b := &FeedBroadcaster{
+		bufferSize:   cfg.BufferSize,
+		pollInterval: cfg.PollInterval,
+		wake:         make(chan struct{}, 1),
+		ready:        make(chan struct{}),
+		done:         make(chan struct{}),
+		subs:         make(map[*feedSubscriber]struct{}),
+	}
+	if b.bufferSize <= 0 {
+		b.bufferSize = defaultWatchBufferSize
+	}
+	if b.pollInterval <= 0 {
+		b.pollInterval = defaultWatchPollInterval
+	}
+	return b
+}
+
+// Run, bağlam iptal edilene kadar değişiklikleri okuyup abonelere dağıtır. Run döndüğünde açık WatchFeed
+// akışları Unavailable ile sonlanır; istemciler son aldıkları belirteçle başka bir kopyaya bağlanabilir.
+func (b *FeedBroadcaster) Run(ctx context.Context) error {
+	defer close(b.done)
+
+	cursor, err := latestFeedPosition(ctx)
+	if err != nil {
+		return err
+	}
+	b.start(cursor)
+
+	go b.listen(ctx)
+
+	ticker := time.NewTicker(b.pollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-b.wake:
+		case <-ticker.C:
+		}
+		if err := b.poll(ctx); err != nil && ctx.Err() == nil {
+			slog.ErrorContext(ctx, "Akış değişiklikleri okunamadı", "error", err)
+		}
+	}
+}
+
+// listen, LISTEN bağlantısını açık tutar ve her bildirimde dağıtım döngüsünü uyandırır.
+func (b *FeedBroadcaster) listen(ctx context.Context) {
+	for ctx.Err() == nil {
+		err := b.waitForNotifications(ctx)
+		if ctx.Err() != nil {
+			return
+		}
+		slog.WarnContext(ctx, "Akış bildirim bağlantısı koptu, yoklamaya devam ediliyor", "error", err)
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(listenRetryDelay):
+		}
+	}
+}
+
+func (b *FeedBroadcaster) waitForNotifications(ctx context.Context) error {
+	conn, err := db.Conn(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	return conn.Raw(func(driverConn any) error {
+		pgConn := driverConn.(*stdlib.Conn).Conn()
+		if _, err := pgConn.Exec(ctx, "LISTEN "+feedEventsChannel); err != nil {
+			return err
+		}
+		for {
+			if _, err := pgConn.WaitForNotification(ctx); err != nil {
+				return err
+			}
+			select {
+			case b.wake <- struct{}{}:
+			default:
+			}
+		}
+	})
+}
+
+// poll, imleçten sonraki kesinleşmiş değişiklikleri okur ve dağıtır.
+func (b *FeedBroadcaster) poll(ctx context.Context) error {
+	for {
+		b.mu.Lock()
+		cursor := b.cursor
+		b.mu.Unlock()
+
+		changes, err := readFeedChanges(ctx, cursor, nil, feedChangeBatchSize)
+		if err != nil || len(changes) == 0 {
+			return err
+		}
+		if err := b.dispatch(ctx, changes); err != nil {
+			return err
+		}
+		if len(changes) < feedChangeBatchSize {
+			return nil
+		}
+	}
+}
+
+// dispatch, değişiklikleri abonelerin filtrelerine göre olaylara çevirir ve kuyruklarına ekler. Aynı filtreye
+// sahip aboneler için eşleşme bir kez hesaplanır. Dağıtım sürerken yeni abone kaydedilmez; böylece abone olan
+// biri değişiklikleri ne kaçırır ne de iki kez alır.
+func (b *FeedBroadcaster) dispatch(ctx context.Context, changes []feedChange) error {
+	b.dispatchMu.Lock()
+	defer b.dispatchMu.Unlock()
+
+	b.mu.Lock()
+	filters := make(map[string]feedFilter)
+	for sub := range b.subs {
+		filters[sub.digest] = sub.filter
+	}
+	b.mu.Unlock()
+
+	events := make(map[string][]*FeedEvent, len(filters))
+	if len(filters) > 0 {
+		set, err := loadFeedChangeSet(ctx, changes)
+		if err != nil {
+			return err
+		}
+		for digest, filter := range filters {
+			matched, err := set.matching(ctx, filter)
+			if err != nil {
+				return err
+			}
+			events[digest] = set.events(matched)
+		}
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	for sub := range b.subs {
+		for _, event := range events[sub.digest] {
+			if !b.offer(sub, event) {
+				break
+			}
+		}
+	}
+	b.cursor = changes[len(changes)-1].pos
+	return nil
+}
+
+// offer, olayı abonenin kuyruğuna ekler. Kuyruk doluysa abone çıkarılır ve false döner.
+func (b *FeedBroadcaster) offer(sub *feedSubscriber, event *FeedEvent) bool {
+	select {
+	case sub.events <- event:
+		return true
+	default:
+		b.evict(sub)
+		return false
+	}
+}
+
+// evict, kuyruğu dolan yavaş bir aboneyi çıkarır. Abone, son aldığı olayın belirteciyle yeniden bağlanabilir.
+func (b *FeedBroadcaster) evict(sub *feedSubscriber) {
+	delete(b.subs, sub)
+	close(sub.evicted)
+}
+
+// start, imleci başlangıç konumuna ayarlar ve bekleyen abonelikleri serbest bırakır.
+func (b *FeedBroadcaster) start(cursor feedPosition) {
+	b.mu.Lock()
+	b.cursor = cursor
+	b.mu.Unlock()
+	close(b.ready)
+}
+
+// subscribe, filtre için yeni bir abone kaydeder. Abone, kayıt anındaki konumdan sonraki değişiklikleri alır.
+// İmleç henüz ayarlanmadıysa beklenir; yoksa abonenin başlangıç konumu sıfır olur ve devam belirtecinden sonraki
+// değişiklikler ne geçmişten ne de canlı olaylardan gönderilir.
+func (b *FeedBroadcaster) subscribe(ctx context.Context, filter feedFilter) (*feedSubscriber, error) {
+	select {
+	case <-b.ready:
+	case <-b.done:
+		return nil, status.Error(codes.Unavailable, "sunucu kapanıyor; resume_token ile yeniden bağlanın")
+	case <-ctx.Done():
+		return nil, status.Error(codes.Unavailable, "akış henüz hazır değil")
+	}
+
+	b.dispatchMu.Lock()
+	defer b.dispatchMu.Unlock()
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	sub := &feedSubscriber{
+		filter:  filter,
+		digest:  filter.digest(),
+		start:   b.cursor,
+		events:  make(chan *FeedEvent, b.bufferSize),
+		evicted: make(chan struct{}),
+	}
+	b.subs[sub] = struct{}{}
+	return sub, nil
+}
+
+// unsubscribe, aboneyi kaldırır. Çıkarılmış bir abone için bir şey yapmaz.
+func (b *FeedBroadcaster) unsubscribe(sub *feedSubscriber) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	delete(b.subs, sub)
+}
+
+// WatchFeed, akışta görünen fotoğraflardaki ekleme, güncelleme ve kaldırmaları filtreye göre anlık olarak gönderir.
+// Devam belirteci verilmişse önce o konumdan bu yana kaçırılan değişiklikler gönderilir.
+func (s *PhotoService) WatchFeed(req *WatchFeedRequest, stream PhotoService_WatchFeedServer) error {
+	ctx := stream.Context()
+	filter, err := newFeedFilter(req.Filter)
+	if err != nil {
+		return err
+	}
+
+	var resume *feedPosition
+	if req.ResumeToken != "" {
+		p, err := decodeResumeToken(req.ResumeToken)
+		if err != nil {
+			return err
+		}
+		ok, err := feedPositionRetained(ctx, p)
+		if err != nil {
+			return status.Errorf(codes.Internal, "devam belirteci doğrulanamadı: %v", err)
+		}
+		if !ok {
+			return status.Error(codes.OutOfRange, "devam belirtecinin süresi doldu; akışı GetImageFeed ile yeniden alın")
+		}
+		resume = &p
+	}
+
+	// Canlı olaylar geçmiş gönderilirken kuyrukta bekler.
+	sub, err := s.feed.subscribe(ctx, filter)
+	if err != nil {
+		return err
+	}
+	defer s.feed.unsubscribe(sub)
+
+	viewer := clientIdentity(ctx)
+	if resume != nil {
+		if err := replayFeedChanges(ctx, stream, viewer, filter, *resume, sub.start); err != nil {
+			return err
+		}
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-s.feed.done:
+			return status.Error(codes.Unavailable, "sunucu kapanıyor; resume_token ile yeniden bağlanın")
+		case event := <-sub.events:
+			if err := sendFeedEvent(stream, viewer, event); err != nil {
+				return err
+			}
+		case <-sub.evicted:
+			// Kuyruktaki olaylar gönderilir; istemci en son belirteçle devam edebilir.
+			for {
+				select {
+				case event := <-sub.events:
+					if err := sendFeedEvent(stream, viewer, event); err != nil {
+						return err
+					}
+				default:
+					return status.Error(codes.ResourceExhausted, "akış olayları yeterince hızlı okunmadı; resume_token ile yeniden bağlanın")
+				}
+			}
+		}
+	}
+}
+
+// sendFeedEvent, olayı izleyiciye gösterilecek kişi bilgisiyle gönderir. Olaylar aynı filtreli abonelerce
+// paylaşıldığından yerinde değiştirilmez.
+func sendFeedEvent(stream PhotoService_WatchFeedServer, viewer string, event *FeedEvent) error {
+	if event.Image != nil {
+		if img := personsVisibleTo(viewer, event.Image); img != event.Image {
+			event = proto.Clone(event).(*FeedEvent)
+			event.Image = img
+		}
+	}
+	return stream.Send(event)
+}
+
+// replayFeedChanges, from ile to konumları arasındaki değişiklikleri filtreye göre gönderir.
+func replayFeedChanges(ctx context.Context, stream PhotoService_WatchFeedServer, viewer string, filter feedFilter, from, to feedPosition) error {
+	for to.after(from) {
+		changes, err := readFeedChanges(ctx, from, &to, feedChangeBatchSize)
+		if err != nil {
+			return status.Errorf(codes.Internal, "akış değişiklikleri okunamadı: %v", err)
+		}
+		if len(changes) == 0 {
+			return nil
+		}
+		set, err := loadFeedChangeSet(ctx, changes)
+		if err != nil {
+			return status.Errorf(codes.Internal, "akış değişiklikleri okunamadı: %v", err)
+		}
+		matched, err := set.matching(ctx, filter)
+		if err != nil {
+			return status.Errorf(codes.Internal, "akış değişiklikleri okunamadı: %v", err)
+		}
+		for _, event := range set.events(matched) {
+			if err := sendFeedEvent(stream, viewer, event); err != nil {
+				return err
+			}
+		}
+		from = changes[len(changes)-1].pos
+	}
+	return nil
+}
@@ -0,0 +1,169 @@
+package photo
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"myphotoapp/config"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// testWatchStream, yalnızca bağlamı ve gönderilen olayları tutan bir WatchFeed akışıdır.
+type testWatchStream struct {
+	grpc.ServerStream
+	ctx    context.Context
+	events []*FeedEvent
+}
+
+func (s *testWatchStream) Context() context.Context { return s.ctx }
+
+func (s *testWatchStream) Send(event *FeedEvent) error {
+	s.events = append(s.events, event)
+	return nil
+}
+
+func TestSubscribeWaitsForCursor(t *testing.T) {
+	b := NewFeedBroadcaster(config.WatchConfig{})
+
+	// İmleç ayarlanmadan abone olunamaz; aksi halde abonenin başlangıç konumu sıfır olurdu.
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if _, err := b.subscribe(ctx, feedFilter{}); status.Code(err) != codes.Unavailable {
+		t.Fatalf("hazır olmadan subscribe = %v, want Unavailable", err)
+	}
+
+	cursor := feedPosition{Tx: 42, Seq: 7}
+	subscribed := make(chan *feedSubscriber, 1)
+	go func() {
+		sub, err := b.subscribe(context.Background(), feedFilter{})
+		if err != nil {
+			t.Errorf("subscribe: %v", err)
+		}
+		subscribed <- sub
+	}()
+	b.start(cursor)
+
+	select {
+	case sub := <-subscribed:
+		if sub == nil || sub.start != cursor {
+			t.Errorf("abonenin başlangıç konumu = %+v, want %+v", sub, cursor)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("subscribe imleç ayarlandıktan sonra dönmedi")
+	}
+}
+
+func TestOfferEvictsSlowSubscriber(t *testing.T) {
+	b := NewFeedBroadcaster(config.WatchConfig{BufferSize: 1})
+	b.start(feedPosition{})
+	sub, err := b.subscribe(context.Background(), feedFilter{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	b.mu.Lock()
+	first := b.offer(sub, &FeedEvent{ImageId: "1"})
+	second := b.offer(sub, &FeedEvent{ImageId: "2"})
+	_, subscribed := b.subs[sub]
+	b.mu.Unlock()
+
+	if !first || second {
+		t.Errorf("offer = %v, %v, want true, false", first, second)
+	}
+	if subscribed {
+		t.Error("kuyruğu dolan abone çıkarılmadı")
+	}
+	select {
+	case <-sub.evicted:
+	default:
+		t.Error("çıkarılan abonenin evicted kanalı kapatılmadı")
+	}
+}
+
+func TestFeedChangeSetEvents(t *testing.T) {
+	at := time.Unix(1700000000, 0)
+	set := &feedChangeSet{
+		changes: []feedChange{
+			{pos: feedPosition{Tx: 1, Seq: 1}, photoID: 1, op: "INSERT", occurredAt: at},
+			{pos: feedPosition{Tx: 1, Seq: 2}, photoID: 2, op: "UPDATE", wasVisible: true, occurredAt: at},
+			{pos: feedPosition{Tx: 2, Seq: 3}, photoID: 3, op: "UPDATE", wasVisible: true, occurredAt: at},
+			{pos: feedPosition{Tx: 2, Seq: 4}, photoID: 4, op: "UPDATE", occurredAt: at},
+		},
+		photos: map[string]*UploadedImage{"1": {Id: "1"}, "2": {Id: "2"}, "4": {Id: "4"}},
+	}
+	// 3 silinmiş, 4 filtreye uymuyor ve önceden de akışta değildi.
+	events := set.events(map[string]bool{"1": true, "2": true})
+
+	want := []FeedEventType{FeedEventType_FEED_EVENT_CREATED, FeedEventType_FEED_EVENT_UPDATED, FeedEventType_FEED_EVENT_REMOVED}
+	if len(events) != len(want) {
+		t.Fatalf("olaylar = %v, want %d olay", events, len(want))
+	}
+	for i, event := range events {
+		if event.Type != want[i] {
+			t.Errorf("olay %d türü = %v, want %v", i, event.Type, want[i])
+		}
+	}
+	if events[2].Image != nil {
+		t.Error("kaldırma olayında fotoğraf gönderilmemeli")
+	}
+	if p, err := decodeResumeToken(events[2].ResumeToken); err != nil || p != (feedPosition{Tx: 2, Seq: 3}) {
+		t.Errorf("devam belirteci = %+v, %v", p, err)
+	}
+}
+
+func TestDecodeResumeTokenRejects(t *testing.T) {
+	for _, token := range []string{"%%%", encodeResumeToken(feedPosition{}), encodeResumeToken(feedPosition{Tx: 1})} {
+		if _, err := decodeResumeToken(token); status.Code(err) != codes.InvalidArgument {
+			t.Errorf("decodeResumeToken(%q) = %v, want InvalidArgument", token, err)
+		}
+	}
+}
+
+func TestSendFeedEventHidesForeignPersons(t *testing.T) {
+	img := &UploadedImage{Id: "1", OwnerId: "203.0.113.7",
+		FaceAnalysis: []*FaceAnalysis{{PersonId: "5", PersonName: "Ayşe"}}}
+	event := &FeedEvent{Type: FeedEventType_FEED_EVENT_CREATED, ImageId: "1", Image: img}
+	stream := &testWatchStream{ctx: context.Background()}
+
+	if err := sendFeedEvent(stream, "198.51.100.1", event); err != nil {
+		t.Fatal(err)
+	}
+	if err := sendFeedEvent(stream, "203.0.113.7", event); err != nil {
+		t.Fatal(err)
+	}
+	if got := stream.events[0].Image.FaceAnalysis[0].PersonName; got != "" {
+		t.Errorf("başka istemciye gönderilen kişi adı = %q, want boş", got)
+	}
+	if stream.events[1] != event || event.Image.FaceAnalysis[0].PersonName != "Ayşe" {
+		t.Error("paylaşılan olay değiştirilmemeli ve sahibe aynen gönderilmeli")
+	}
+}
+
+func TestWatchFeedEndsWhenBroadcasterStops(t *testing.T) {
+	for _, started := range []bool{false, true} {
+		feed := NewFeedBroadcaster(config.WatchConfig{})
+		if started {
+			feed.start(feedPosition{Tx: 1, Seq: 1})
+		}
+		s := &PhotoService{feed: feed}
+		stream := &testWatchStream{ctx: context.Background()}
+
+		result := make(chan error, 1)
+		go func() { result <- s.WatchFeed(&WatchFeedRequest{}, stream) }()
+
+		// Dağıtıcı durduğunda (Run döndüğünde) açık ya da imleci bekleyen akış sonlanmalıdır.
+		close(feed.done)
+		select {
+		case err := <-result:
+			if status.Code(err) != codes.Unavailable {
+				t.Errorf("başlamış=%v: WatchFeed = %v, want Unavailable", started, err)
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatalf("başlamış=%v: WatchFeed dağıtıcı durduktan sonra sonlanmadı", started)
+		}
+	}
+}
@@ -0,0 +1,143 @@
+package photo
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+
+	"myphotoapp/config"
+)
+
+const (
+	defaultRollupRefreshInterval = time.Minute      // Duygu özetinin yapılandırma verilmediğinde yenilenme aralığı
+	feedEventsPruneInterval      = time.Hour        // Eski akış değişikliklerinin silinme aralığı
+	visionCachePruneInterval     = time.Hour        // Süresi dolmuş Vision sonuçlarının silinme aralığı
+	analysisQueueInterval        = 15 * time.Second // Bekleyen analizlerin yeniden denenme aralığı
+)
+
+// Worker, istek akışı dışında düzenli çalışması gereken işleri yürütür.
+type Worker struct {
+	photos          *PhotoService
+	rollup          bool
+	refreshInterval time.Duration
+	watchRetention  time.Duration
+	visionCacheTTL  time.Duration // 0 ise Postgres önbelleği kullanılmıyordur
+}
+
+// NewWorker, yapılandırmaya göre yeni bir Worker oluşturur.
+func NewWorker(cfg *config.Config, photos *PhotoService) *Worker {
+	w := &Worker{
+		photos:          photos,
+		rollup:          cfg.Analytics.Rollup,
+		refreshInterval: cfg.Analytics.RefreshInterval,
+		watchRetention:  cfg.Watch.Retention,
+	}
+	if w.refreshInterval <= 0 {
+		w.refreshInterval = defaultRollupRefreshInterval
+	}
+	if w.watchRetention <= 0 {
+		w.watchRetention = defaultWatchRetention
+	}
+	if cache := cfg.Vision.Cache; cache.Enabled && cache.Postgres {
+		w.visionCacheTTL = cache.TTL
+		if w.visionCacheTTL <= 0 {
+			w.visionCacheTTL = defaultVisionCacheTTL
+		}
+	}
+	return w
+}
+
+// Run, bağlam iptal edilene kadar arka plan işlerini çalıştırır ve hepsi durunca döner.
+func (w *Worker) Run(ctx context.Context) {
+	var wg sync.WaitGroup
+	start := func(interval time.Duration, job func(context.Context)) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			every(ctx, interval, job)
+		}()
+	}
+
+	if w.rollup {
+		start(w.refreshInterval, w.refreshRollup)
+	}
+	start(feedEventsPruneInterval, w.pruneFeedEvents)
+	start(analysisQueueInterval, w.processAnalysisQueue)
+	if w.visionCacheTTL > 0 {
+		start(visionCachePruneInterval, w.pruneVisionCache)
+	}
+	wg.Wait()
+}
+
+// every, işi hemen ve ardından her aralıkta bağlam iptal edilene kadar çalıştırır.
+func every(ctx context.Context, interval time.Duration, job func(context.Context)) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		job(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (w *Worker) refreshRollup(ctx context.Context) {
+	n, err := RefreshEmotionRollup(ctx)
+	if err != nil {
+		slog.ErrorContext(ctx, "Duygu özeti yenilenemedi", "error", err)
+		return
+	}
+	if n > 0 {
+		slog.InfoContext(ctx, "Duygu özeti yenilendi", "hours", n)
+	}
+}
+
+func (w *Worker) pruneFeedEvents(ctx context.Context) {
+	n, err := PruneFeedEvents(ctx, w.watchRetention)
+	if err != nil {
+		slog.ErrorContext(ctx, "Eski akış değişiklikleri silinemedi", "error", err)
+		return
+	}
+	if n > 0 {
+		slog.InfoContext(ctx, "Eski akış değişiklikleri silindi", "count", n)
+	}
+}
+
+func (w *Worker) pruneVisionCache(ctx context.Context) {
+	n, err := PruneVisionCache(ctx, w.visionCacheTTL)
+	if err != nil {
+		slog.ErrorContext(ctx, "Süresi dolmuş Vision sonuçları silinemedi", "error", err)
+		return
+	}
+	if n > 0 {
+		slog.InfoContext(ctx, "Süresi dolmuş Vision sonuçları silindi", "count", n)
+	}
+}
+
+func (w *Worker) processAnalysisQueue(ctx context.Context) {
+	defer w.updateAnalysisBacklog(ctx)
+
+	n, err := w.photos.ProcessAnalysisQueue(ctx)
+	if err != nil && ctx.Err() == nil {
+		slog.ErrorContext(ctx, "Analiz kuyruğu işlenemedi", "error", err)
+		return
+	}
+	if n > 0 {
+		slog.InfoContext(ctx, "Kuyruktaki fotoğrafların analizi tamamlandı", "count", n)
+	}
+}
+
+// updateAnalysisBacklog, analizi bekleyen fotoğraf sayısını ölçüme yazar.
+func (w *Worker) updateAnalysisBacklog(ctx context.Context) {
+	n, err := PendingAnalysisCount(ctx)
+	if err != nil {
+		if ctx.Err() == nil {
+			slog.ErrorContext(ctx, "Analiz kuyruğu sayılamadı", "error", err)
+		}
+		return
+	}
+	analysisBacklog.Set(float64(n))
+}
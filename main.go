@@ -4,24 +4,71 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"log/slog"
 	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
 
+	"myphotoapp/config"
 	"myphotoapp/internal/photo"
 
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/reflection"
 )
 
 const (
-	port = ":50051"
+	port                   = ":50051"
+	defaultShutdownTimeout = 15 * time.Second
 )
 
-// PhotoServiceServerImpl struct'ını tanımlar.
-type PhotoServiceServerImpl struct {
-	photo.UnimplementedPhotoServiceServer
-	photoService *photo.PhotoService
-}
-
 func main() {
+	// Sunucu bir hata yüzünden durduysa süreç, diğer bütün defer'ler çalıştıktan sonra hata koduyla çıkar.
+	exitCode := 0
+	defer func() {
+		if exitCode != 0 {
+			os.Exit(exitCode)
+		}
+	}()
+
+	// SIGTERM ya da SIGINT gelince ctx iptal edilir ve kapanış başlar.
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGTERM, os.Interrupt)
+	defer stop()
+
+	// Arka planda çalışan sunucuların ve akış dağıtıcısının hataları. Hata gelince süreç hemen sonlandırılmaz;
+	// kapanış başlar ve Kafka üreticisi, veritabanı gibi kaynaklar defer'lerle kapatılır.
+	fatalErr := make(chan error, 4)
+
+	// Uygulama konfigürasyonunu yükler.
+	cfg, err := config.LoadConfig("config/config.yaml")
+	if err != nil {
+		log.Fatalf("Konfigürasyon dosyası okunamadı: %v", err)
+	}
+
+	// Günlükleri yapılandırılan biçim ve seviyeyle yazar.
+	if err := photo.InitLogging(cfg.Log); err != nil {
+		log.Fatalf("Günlükleme başlatılamadı: %v", err)
+	}
+
+	// İzleri yapılandırılan dışa aktarıcıya gönderir. Kapanışta bekleyen span'ler gönderilir.
+	shutdownTracing, err := photo.InitTracing(ctx, cfg.Tracing)
+	if err != nil {
+		log.Fatalf("İzleme başlatılamadı: %v", err)
+	}
+	defer func() {
+		tracingCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if err := shutdownTracing(tracingCtx); err != nil {
+			slog.Error("İzleme kapatılamadı", "error", err)
+		}
+	}()
+
 	// gRPC sunucu dinleyiciyi oluşturur.
 	listener, err := net.Listen("tcp", port)
 	if err != nil {
@@ -35,7 +82,7 @@ func main() {
 	}
 	defer func() {
 		if cerr := photo.CloseDB(); cerr != nil {
-			log.Fatalf("Veritabanı bağlantısı kapatılamadı: %v", cerr)
+			slog.Error("Veritabanı bağlantısı kapatılamadı", "error", cerr)
 		}
 	}()
 
@@ -45,21 +92,77 @@ func main() {
 		log.Fatalf("Tablo oluşturulamadı: %v", err)
 	}
 
+	// Duygu istatistikleri için saatlik özeti hazırlar.
+	if err := photo.SetupEmotionRollup(cfg.Analytics.Rollup); err != nil {
+		log.Fatalf("Duygu özeti hazırlanamadı: %v", err)
+	}
+
 	// Kafka üretici ve Vision API istemcisini oluşturur.
-	kafkaProducer, err := photo.NewKafkaProducer()
+	kafkaProducer, err := photo.NewKafkaProducer(cfg.Kafka)
 	if err != nil {
 		log.Fatalf("Kafka üretici başlatılamadı: %v", err)
 	}
+	// Kapanışta kuyruktaki mesajlar yapılandırılan süre kadar teslim edilmeye çalışılır.
 	defer kafkaProducer.Close()
 
-	visionAPI, err := photo.NewVisionAPI(context.Background(), "config/myphotoapp-412717-7662d103da43.json")
+	visionAPI, err := photo.NewVisionAPI(context.Background(), "config/myphotoapp-412717-7662d103da43.json", cfg.Vision)
 	if err != nil {
 		log.Fatalf("Vision API istemcisi oluşturulamadı: %v", err)
 	}
 	defer visionAPI.Close()
 
+	// Coğrafi arama için konum dizinini oluşturur.
+	locations, err := photo.NewLocationRepository(context.Background(), cfg.Geo.Index)
+	if err != nil {
+		log.Fatalf("Konum dizini oluşturulamadı: %v", err)
+	}
+
+	// Duygu kurallarını yükler ve dosya değiştikçe yeniden yükler.
+	emotions, err := photo.NewEmotionClassifier(cfg.Emotion)
+	if err != nil {
+		log.Fatalf("Duygu kuralları yüklenemedi: %v", err)
+	}
+	go emotions.Watch(ctx)
+
+	// WatchFeed abonelerine fotoğraf değişikliklerini dağıtır.
+	feed := photo.NewFeedBroadcaster(cfg.Watch)
+	go func() {
+		if err := feed.Run(ctx); err != nil {
+			fatalErr <- fmt.Errorf("akış dağıtıcısı başlatılamadı: %w", err)
+		}
+	}()
+
 	// PhotoService oluşturur.
-	photoService := photo.NewPhotoService(kafkaProducer, visionAPI)
+	photoService, err := photo.NewPhotoService(kafkaProducer, visionAPI, locations, emotions, feed, cfg)
+	if err != nil {
+		log.Fatalf("PhotoService oluşturulamadı: %v", err)
+	}
+
+	// Arka plan işlerini başlatır. Kapanışta işlerin bitmesi workerDone ile beklenir.
+	workerDone := make(chan struct{})
+	go func() {
+		defer close(workerDone)
+		photo.NewWorker(cfg, photoService).Run(ctx)
+	}()
+
+	// Bağımlılıkların durumunu grpc.health.v1 servisinde yayımlar.
+	healthChecker := photo.NewHealthChecker(cfg.Health, kafkaProducer, visionAPI)
+	go healthChecker.Run(ctx)
+
+	var httpServers []*http.Server
+
+	// Prometheus ölçümlerini ve Vision API devre kesicisi gibi işletim bilgilerini yönetim uç noktasında sunar.
+	metrics := photo.NewMetricsRegistry(kafkaProducer)
+	if cfg.Admin.Addr != "" {
+		adminServer := &http.Server{Addr: cfg.Admin.Addr, Handler: photo.NewAdminHandler(visionAPI, metrics)}
+		httpServers = append(httpServers, adminServer)
+		go func() {
+			slog.Info("Yönetim uç noktası dinleniyor", "addr", cfg.Admin.Addr)
+			if err := adminServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+				fatalErr <- fmt.Errorf("yönetim uç noktası başlatılamadı: %w", err)
+			}
+		}()
+	}
 
 	// UploadImage örneği 1
 	image1 := &photo.UploadedImage{
@@ -126,19 +229,111 @@ func main() {
 		log.Fatalf("Fotoğraf detayı güncellenirken hata oluştu: %v", err)
 	}
 
-	log.Printf("Güncellenmiş fotoğraf detayı: %v", updatedDetail)
+	slog.Info("Güncellenmiş fotoğraf detayı", "image", updatedDetail)
 
 	// gRPC sunucu oluşturur.
-	grpcServer := grpc.NewServer()
+	// İstemci ve RPC başına istek sınırı uygular.
+	// Her RPC için, gelen istekteki izin devamı olan bir span başlatılır.
+	// RPC süreleri ve durum kodları, istek sınırına takılan çağrılar da dahil ölçülür.
+	opts := []grpc.ServerOption{
+		grpc.StatsHandler(otelgrpc.NewServerHandler()),
+		grpc.ChainUnaryInterceptor(photo.RequestIDUnaryInterceptor(), photo.MetricsUnaryInterceptor()),
+		grpc.ChainStreamInterceptor(photo.RequestIDStreamInterceptor(), photo.MetricsStreamInterceptor()),
+	}
+	if limiter := photo.NewRateLimiter(cfg.RateLimit); limiter != nil {
+		opts = append(opts,
+			grpc.ChainUnaryInterceptor(limiter.UnaryInterceptor()),
+			grpc.ChainStreamInterceptor(limiter.StreamInterceptor()))
+	}
+	grpcServer := grpc.NewServer(opts...)
 
 	// PhotoService sunucuya ekler.
-	photoServiceServerImpl := &PhotoServiceServerImpl{photoService: photoService}
-	photo.RegisterPhotoServiceServer(grpcServer, photoServiceServerImpl)
+	photo.RegisterPhotoServiceServer(grpcServer, photoService)
+	photo.RegisterModerationServiceServer(grpcServer, photo.NewModerationServer(locations, cfg.Moderation))
+	photo.RegisterPeopleServiceServer(grpcServer, photo.NewPeopleServer())
+	photo.RegisterAnalyticsServiceServer(grpcServer, photo.NewAnalyticsServer(cfg.Analytics))
+	healthpb.RegisterHealthServer(grpcServer, healthChecker.Server())
+	if cfg.Server.Reflection {
+		reflection.Register(grpcServer)
+	}
 
-	log.Printf("gRPC sunucusu %s üzerinde dinleniyor", port)
+	// Web istemcileri için PhotoService'i HTTP/JSON olarak sunar. İstekler gRPC sunucusuna iletilir.
+	if cfg.Gateway.Addr != "" {
+		conn, err := grpc.Dial("localhost"+port, grpc.WithTransportCredentials(insecure.NewCredentials()),
+			grpc.WithStatsHandler(otelgrpc.NewClientHandler()))
+		if err != nil {
+			log.Fatalf("REST geçidi gRPC sunucusuna bağlanamadı: %v", err)
+		}
+		defer conn.Close()
+		gatewayServer := &http.Server{Addr: cfg.Gateway.Addr, Handler: otelhttp.NewHandler(photo.NewGateway(conn), "gateway")}
+		httpServers = append(httpServers, gatewayServer)
+		go func() {
+			slog.Info("REST geçidi dinleniyor", "addr", cfg.Gateway.Addr)
+			if err := gatewayServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+				fatalErr <- fmt.Errorf("REST geçidi başlatılamadı: %w", err)
+			}
+		}()
+	}
+
+	slog.Info("gRPC sunucusu dinleniyor", "addr", port)
 
 	// Sunucuyu başlatır.
-	if err := grpcServer.Serve(listener); err != nil {
-		log.Fatalf("Sunucu başlatılamadı: %v", err)
+	go func() {
+		if err := grpcServer.Serve(listener); err != nil {
+			fatalErr <- fmt.Errorf("sunucu başlatılamadı: %w", err)
+		}
+	}()
+	select {
+	case err := <-fatalErr:
+		slog.Error("Sunucu durduruluyor", "error", err)
+		exitCode = 1
+		// Arka plan işlerinin de durması için bağlam iptal edilir.
+		stop()
+	case <-ctx.Done():
+	}
+
+	shutdown(cfg.Server.ShutdownTimeout, grpcServer, httpServers, healthChecker, workerDone)
+}
+
+// shutdown, sunucuyu verilen süre içinde kapatır. Önce yeni istekler durdurulur ve açık isteklerin bitmesi
+// beklenir; süre dolarsa kalan bağlantılar kesilir. Ardından arka plan işleri beklenir. Kafka üreticisi,
+// veritabanı ve diğer istemciler main'deki defer'lerle kapanır; Kafka kuyruğu kapanırken boşaltılır.
+func shutdown(timeout time.Duration, grpcServer *grpc.Server, httpServers []*http.Server,
+	healthChecker *photo.HealthChecker, workerDone <-chan struct{}) {
+	if timeout <= 0 {
+		timeout = defaultShutdownTimeout
 	}
+	deadline := time.Now().Add(timeout)
+	slog.Info("Kapanış başladı", "timeout", timeout)
+
+	// Yük dengeleyicilerin yeni istek göndermeyi bırakması için sağlık durumu hemen NOT_SERVING olur.
+	healthChecker.Shutdown()
+
+	shutdownCtx, cancel := context.WithDeadline(context.Background(), deadline)
+	defer cancel()
+	for _, srv := range httpServers {
+		if err := srv.Shutdown(shutdownCtx); err != nil {
+			slog.Error("HTTP sunucusu kapatılamadı", "addr", srv.Addr, "error", err)
+		}
+	}
+
+	stopped := make(chan struct{})
+	go func() {
+		grpcServer.GracefulStop()
+		close(stopped)
+	}()
+	select {
+	case <-stopped:
+	case <-shutdownCtx.Done():
+		slog.Warn("Açık istekler süresinde bitmedi, bağlantılar kesiliyor")
+		grpcServer.Stop()
+	}
+
+	select {
+	case <-workerDone:
+	case <-shutdownCtx.Done():
+		slog.Warn("Arka plan işleri süresinde bitmedi")
+	}
+
+	slog.Info("Kapanış tamamlandı")
 }